@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -104,12 +105,13 @@ func DataSourceDatabase() *schema.Resource {
 }
 
 func dataSourceDatabaseRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	config := m.(*utils.CombinedConfig)
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	region, err := utils.ResolveRegion(d.Get("region").(string), config)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	apiClient := utils.ClientForRegion(config, region)
 
 	var foundDatabase *civogo.Database
 
@@ -2,8 +2,8 @@ package database
 
 import (
 	"fmt"
-	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,7 +29,7 @@ func DataDatabaseVersion() *schema.Resource {
 }
 
 func getVersion(m interface{}, _ map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), "")
 
 	versions := []interface{}{}
 	partialVersions, err := apiClient.ListDBVersions()
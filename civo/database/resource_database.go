@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -68,6 +69,11 @@ func ResourceDatabase() *schema.Resource {
 				Optional:    true,
 				Description: "The region where the database will be created.",
 			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uniform resource name (URN) of the database, in the form `civo:database:<region>:<id>`",
+			},
 			"username": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -116,12 +122,7 @@ func ResourceDatabase() *schema.Resource {
 
 // function to create a database
 func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] configuring the database %s", d.Get("name").(string))
 
@@ -175,16 +176,17 @@ func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m inter
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{"Pending"},
 		Target:  []string{"Ready"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("database %s", d.Id()), func() (interface{}, string, error) {
 			resp, err := apiClient.GetDatabase(d.Id())
 			if err != nil {
 				return 0, "", err
 			}
 			return resp, resp.Status, nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 60,
 	}
 	_, err = createStateConf.WaitForStateContext(ctx)
@@ -197,12 +199,7 @@ func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 // Function to Update the database
 func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	_, err := apiClient.FindDatabase(d.Id())
 	if err != nil {
@@ -239,12 +236,7 @@ func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 // Function to Read the database
 func resourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] retriving the Database %s", d.Id())
 	resp, err := apiClient.GetDatabase(d.Id())
@@ -264,6 +256,7 @@ func resourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.Set("network_id", resp.NetworkID)
 	d.Set("firewall_id", resp.FirewallID)
 	d.Set("region", apiClient.Region)
+	d.Set("urn", utils.URN("database", apiClient.Region, d.Id()))
 	d.Set("username", resp.Username)
 	d.Set("password", resp.Password)
 	d.Set("endpoint", resp.PublicIPv4)
@@ -276,12 +269,7 @@ func resourceDatabaseRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 // Function to delete the database
 func resourceDatabaseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] deleting the Database %s", d.Id())
 	_, err := apiClient.DeleteDatabase(d.Id())
@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -102,7 +103,7 @@ func CivoDatabaseResourceExists(n string, database *civogo.Database) resource.Te
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 		resp, err := client.FindDatabase(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Database not found: (%s) %s", rs.Primary.ID, err)
@@ -127,7 +128,7 @@ func CivoDatabaseUpdated(database *civogo.Database, name string) resource.TestCh
 
 // CivoDatabaseDestroy is used to destroy the database created during the test
 func CivoDatabaseDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_database" {
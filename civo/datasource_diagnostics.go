@@ -0,0 +1,155 @@
+package civo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// redacted replaces the secrets in the diagnostics
+const redacted = "[REDACTED]"
+
+// dataSourceDiagnostics Data source gathering how the provider is configured and whether it can
+// reach the API, so it can be shared in one go in a support ticket
+func dataSourceDiagnostics() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the version and the settings of the provider, with its secrets redacted, along with a check of the Civo API, to share in support tickets.",
+		ReadContext: dataSourceDiagnosticsRead,
+		Schema: map[string]*schema.Schema{
+			"provider_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the provider",
+			},
+			"civogo_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the Civo API client (civogo) the provider was built with",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region configured in the provider",
+			},
+			"api_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint the provider talks to",
+			},
+			"settings": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The settings of the provider, the token and the credentials in the URLs are redacted and the maps are encoded in JSON",
+			},
+			"api_reachable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the API answered an authenticated request",
+			},
+			"api_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "`ok` when the API answered, otherwise the error it failed with",
+			},
+			"api_latency_ms": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "How long the API took to answer, in milliseconds",
+			},
+		},
+	}
+}
+
+func dataSourceDiagnosticsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*utils.CombinedConfig)
+	apiClient := utils.ClientForRegion(config, "")
+
+	token := ""
+	if apiClient.APIKey != "" {
+		token = redacted
+	}
+
+	settings := map[string]string{
+		"token":                     token,
+		"skip_quota_check":          strconv.FormatBool(config.SkipQuotaCheck),
+		"skip_capacity_check":       strconv.FormatBool(config.SkipCapacityCheck),
+		"default_firewall_id":       config.DefaultFirewallID,
+		"default_sshkey_id":         config.DefaultSSHKeyID,
+		"poll_jitter_percent":       strconv.Itoa(config.PollJitterPercent),
+		"max_poll_interval_seconds": strconv.Itoa(int(config.MaxPollInterval / time.Second)),
+		"verbose_errors":            strconv.FormatBool(config.VerboseErrors),
+		"log_state_transitions":     strconv.FormatBool(config.LogStateTransitions),
+		"max_concurrent_instances":  strconv.Itoa(cap(config.InstanceCreates)),
+		"region_api_urls":           jsonSetting(redactedURLs(config.RegionAPIURLs)),
+		"tag_firewall_map":          jsonSetting(config.TagFirewalls),
+	}
+
+	// listing the regions is a cheap request which still needs a valid token
+	start := time.Now()
+	_, pingErr := apiClient.ListRegions()
+	latency := time.Since(start)
+
+	status := "ok"
+	if pingErr != nil {
+		status = fmt.Sprintf("error: %s", pingErr)
+		if apiClient.APIKey != "" {
+			status = strings.ReplaceAll(status, apiClient.APIKey, redacted)
+		}
+	}
+
+	d.SetId(ProviderVersion)
+	d.Set("provider_version", ProviderVersion)
+	d.Set("civogo_version", civogoVersion())
+	d.Set("region", config.Region)
+	d.Set("api_endpoint", redactedURL(config.APIEndpoint))
+	if err := d.Set("settings", settings); err != nil {
+		return diag.Errorf("[ERR] error setting the settings of the provider: %s", err)
+	}
+	d.Set("api_reachable", pingErr == nil)
+	d.Set("api_status", status)
+	d.Set("api_latency_ms", int(latency.Milliseconds()))
+
+	return nil
+}
+
+// redactedURL replaces the credentials a URL may carry in its userinfo
+func redactedURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+
+	u.User = nil
+	return strings.Replace(u.String(), "://", "://"+redacted+"@", 1)
+}
+
+// redactedURLs returns a copy of the map of URLs with their credentials redacted
+func redactedURLs(urls map[string]string) map[string]string {
+	redactedURLs := map[string]string{}
+	for key, rawURL := range urls {
+		redactedURLs[key] = redactedURL(rawURL)
+	}
+	return redactedURLs
+}
+
+// jsonSetting encodes a map setting of the provider, the settings can only hold strings
+func jsonSetting(setting map[string]string) string {
+	if len(setting) == 0 {
+		return ""
+	}
+
+	encoded, err := json.Marshal(setting)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
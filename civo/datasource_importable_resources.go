@@ -0,0 +1,242 @@
+package civo
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// importableResource is an existing resource of the account which can be imported in Terraform
+type importableResource struct {
+	ID   string
+	Name string
+
+	// ResourceName is the unique Terraform resource name the resource is imported to
+	ResourceName string
+}
+
+// importableResourceLists lists the existing resources of every resource type which can be imported
+var importableResourceLists = map[string]func(apiClient *civogo.Client) ([]importableResource, error){
+	"civo_instance": func(apiClient *civogo.Client) ([]importableResource, error) {
+		instances, err := apiClient.ListAllInstances()
+		if err != nil {
+			return nil, err
+		}
+		resources := []importableResource{}
+		for _, instance := range instances {
+			resources = append(resources, importableResource{ID: instance.ID, Name: instance.Hostname})
+		}
+		return resources, nil
+	},
+	"civo_volume": func(apiClient *civogo.Client) ([]importableResource, error) {
+		volumes, err := apiClient.ListVolumes()
+		if err != nil {
+			return nil, err
+		}
+		resources := []importableResource{}
+		for _, volume := range volumes {
+			resources = append(resources, importableResource{ID: volume.ID, Name: volume.Name})
+		}
+		return resources, nil
+	},
+	"civo_firewall": func(apiClient *civogo.Client) ([]importableResource, error) {
+		firewalls, err := apiClient.ListFirewalls()
+		if err != nil {
+			return nil, err
+		}
+		resources := []importableResource{}
+		for _, firewall := range firewalls {
+			resources = append(resources, importableResource{ID: firewall.ID, Name: firewall.Name})
+		}
+		return resources, nil
+	},
+	"civo_network": func(apiClient *civogo.Client) ([]importableResource, error) {
+		networks, err := apiClient.ListNetworks()
+		if err != nil {
+			return nil, err
+		}
+		resources := []importableResource{}
+		for _, network := range networks {
+			resources = append(resources, importableResource{ID: network.ID, Name: network.Label})
+		}
+		return resources, nil
+	},
+	"civo_dns_domain_name": func(apiClient *civogo.Client) ([]importableResource, error) {
+		domains, err := apiClient.ListDNSDomains()
+		if err != nil {
+			return nil, err
+		}
+		resources := []importableResource{}
+		for _, domain := range domains {
+			resources = append(resources, importableResource{ID: domain.ID, Name: domain.Name})
+		}
+		return resources, nil
+	},
+}
+
+// importableResourceTypes returns the resource types the data source can list, sorted
+func importableResourceTypes() []string {
+	types := make([]string, 0, len(importableResourceLists))
+	for resourceType := range importableResourceLists {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// dataSourceImportableResources lists the existing resources of a type along with the
+// `terraform import` command bringing each of them under Terraform
+func dataSourceImportableResources() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		Description: "Get the existing resources of a type in your Civo account, with the `terraform import` command for each of them, to help bringing an existing account under Terraform.",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(importableResourceTypes(), false),
+				Description:  "The type of the resources to list, one of " + utils.GetCommaSeparatedAllowedKeys(importableResourceTypes()),
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If is used, all the resources will be from this region, DNS domains don't belong to a region. Required if no region is set in provider. The import of an instance, firewall or network looks it up in the region of the provider, so one from another region has to be declared with a provider configured for its region, volumes are found in any region",
+			},
+		},
+		RecordSchema:        importableResourceSchema(),
+		ResultAttributeName: "resources",
+		FlattenRecord:       flattenImportableResource,
+		GetRecords:          getImportableResources,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getImportableResources(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	config := m.(*utils.CombinedConfig)
+
+	region, err := utils.ResolveRegion(extra["region"].(string), config)
+	if err != nil {
+		return nil, err
+	}
+	apiClient := utils.ClientForRegion(config, region)
+
+	resourceType := extra["type"].(string)
+	list, ok := importableResourceLists[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("[ERR] the resources of type %s can't be listed", resourceType)
+	}
+
+	found, err := list(apiClient)
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving the %s resources: %s", resourceType, err)
+	}
+
+	names := importableResourceNames(found)
+
+	resources := []interface{}{}
+	for i, resource := range found {
+		resource.ResourceName = names[i]
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+func flattenImportableResource(record, _ interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	resource := record.(importableResource)
+	resourceType := extra["type"].(string)
+	address := fmt.Sprintf("%s.%s", resourceType, resource.ResourceName)
+
+	flattenedResource := map[string]interface{}{}
+	flattenedResource["id"] = resource.ID
+	flattenedResource["name"] = resource.Name
+	flattenedResource["address"] = address
+	flattenedResource["import_command"] = fmt.Sprintf("terraform import %s %s", address, resource.ID)
+
+	return flattenedResource, nil
+}
+
+var (
+	invalidResourceNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+	validResourceNameStart        = regexp.MustCompile(`^[a-zA-Z_]`)
+)
+
+// importableResourceName turns the name of the resource into a valid Terraform resource name,
+// falling back to its ID for a resource without a name
+func importableResourceName(resource importableResource) string {
+	name := resource.Name
+	if name == "" {
+		name = resource.ID
+	}
+
+	name = invalidResourceNameCharacters.ReplaceAllString(name, "_")
+	if !validResourceNameStart.MatchString(name) {
+		name = "_" + name
+	}
+
+	return name
+}
+
+// importableResourceNames returns the resource names of the resources, in the same order. The
+// resources named the same get a numeric suffix, the one with the lowest ID keeping the name, so the
+// names don't depend on the order the API lists the resources in
+func importableResourceNames(resources []importableResource) []string {
+	names := make([]string, len(resources))
+	taken := map[string]bool{}
+	for i, resource := range resources {
+		names[i] = importableResourceName(resource)
+		taken[names[i]] = true
+	}
+
+	order := make([]int, len(resources))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		if names[order[a]] != names[order[b]] {
+			return names[order[a]] < names[order[b]]
+		}
+		return resources[order[a]].ID < resources[order[b]].ID
+	})
+
+	assigned := map[string]bool{}
+	for _, i := range order {
+		name := names[i]
+		for suffix := 2; assigned[name]; suffix++ {
+			if candidate := fmt.Sprintf("%s_%d", names[i], suffix); !taken[candidate] {
+				name = candidate
+			}
+		}
+		assigned[name] = true
+		names[i] = name
+	}
+
+	return names
+}
+
+func importableResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the resource",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of the resource, the hostname for an instance and the label for a network",
+		},
+		"address": {
+			Type:        schema.TypeString,
+			Description: "A resource address to import the resource to, named after the resource",
+		},
+		"import_command": {
+			Type:        schema.TypeString,
+			Description: "The `terraform import` command importing the resource to `address`, see `region` for the resources outside the region of the provider",
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package civo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestDataSourceImportableResourcesInstances tests the import commands generated for the instances of a region
+func TestDataSourceImportableResourcesInstances(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v2/instances" || req.URL.Query().Get("region") != "LON1" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": [
+			{"id": "b8a9ff5b-0d4c-4a5c-9f3e-1e2d3c4b5a60", "hostname": "web-1.example.com"},
+			{"id": "0c7e6a1f-2b3d-4e5f-8a9b-c0d1e2f3a4b5", "hostname": "1-db"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+	config := &utils.CombinedConfig{Client: client, Region: "LON1"}
+
+	dataSource := dataSourceImportableResources()
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"type":   "civo_instance",
+		"region": "LON1",
+	})
+
+	if diags := dataSource.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []string{
+		"terraform import civo_instance.web-1_example_com b8a9ff5b-0d4c-4a5c-9f3e-1e2d3c4b5a60",
+		"terraform import civo_instance._1-db 0c7e6a1f-2b3d-4e5f-8a9b-c0d1e2f3a4b5",
+	}
+
+	resources := d.Get("resources").([]interface{})
+	if len(resources) != len(expected) {
+		t.Fatalf("expected %d resources, got %v", len(expected), resources)
+	}
+
+	for i, resource := range resources {
+		if got := resource.(map[string]interface{})["import_command"]; got != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], got)
+		}
+	}
+}
+
+// TestImportableResourceNames checks the resources named the same get unique resource names
+func TestImportableResourceNames(t *testing.T) {
+	resources := []importableResource{
+		{ID: "c", Name: "web"},
+		{ID: "a", Name: "web"},
+		{ID: "d", Name: "web_2"},
+		{ID: "b", Name: "web"},
+		{ID: "e", Name: "db"},
+	}
+
+	got := importableResourceNames(resources)
+	expected := []string{"web_4", "web", "web_2", "web_3", "db"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
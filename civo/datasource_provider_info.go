@@ -0,0 +1,75 @@
+package civo
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// civogoModule is the module path of the Civo API client
+const civogoModule = "github.com/civo/civogo"
+
+// dataSourceProviderInfo Data source with the version of the provider and how it is configured,
+// useful for debugging and support tickets
+func dataSourceProviderInfo() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get information on the provider itself: its version, the version of the Civo API client it was built with and the region and API endpoint it is configured with.",
+		ReadContext: dataSourceProviderInfoRead,
+		Schema: map[string]*schema.Schema{
+			"provider_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the provider",
+			},
+			"civogo_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the Civo API client (civogo) the provider was built with",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region configured in the provider",
+			},
+			"api_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint the provider talks to",
+			},
+		},
+	}
+}
+
+func dataSourceProviderInfoRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(*utils.CombinedConfig)
+
+	d.SetId(ProviderVersion)
+	d.Set("provider_version", ProviderVersion)
+	d.Set("civogo_version", civogoVersion())
+	d.Set("region", config.Region)
+	d.Set("api_endpoint", config.APIEndpoint)
+
+	return nil
+}
+
+// civogoVersion returns the version of civogo from the build information of the binary
+func civogoVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+
+	for _, dep := range info.Deps {
+		if dep.Path == civogoModule {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+
+	return "unknown"
+}
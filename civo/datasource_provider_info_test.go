@@ -0,0 +1,29 @@
+package civo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestDataSourceProviderInfoRead tests that the version fields are populated
+func TestDataSourceProviderInfoRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceProviderInfo().Schema, map[string]interface{}{})
+	config := &utils.CombinedConfig{Region: "LON1", APIEndpoint: ProdAPI}
+
+	if diags := dataSourceProviderInfoRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %s", diagnosticsToString(diags))
+	}
+
+	for _, key := range []string{"provider_version", "civogo_version", "region", "api_endpoint"} {
+		if d.Get(key).(string) == "" {
+			t.Errorf("expected %s to be set", key)
+		}
+	}
+
+	if d.Get("region").(string) != "LON1" {
+		t.Errorf("expected the configured region, got %s", d.Get("region"))
+	}
+}
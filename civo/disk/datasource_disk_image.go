@@ -3,8 +3,8 @@ package disk
 import (
 	"fmt"
 
-	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -39,17 +39,18 @@ func DataSourceDiskImage() *schema.Resource {
 }
 
 func getDiskimages(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
+	config := m.(*utils.CombinedConfig)
 
-	// overwrite the region if is define in the datasource
 	region, ok := extra["region"].(string)
 	if !ok {
 		return nil, fmt.Errorf("unable to find `region` key from query data")
 	}
 
-	if region != "" {
-		apiClient.Region = region
+	region, err := utils.ResolveRegion(region, config)
+	if err != nil {
+		return nil, err
 	}
+	apiClient := utils.ClientForRegion(config, region)
 
 	templateDiskList := []TemplateDisk{}
 
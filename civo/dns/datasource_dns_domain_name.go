@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -39,7 +40,7 @@ func DataSourceDNSDomainName() *schema.Resource {
 }
 
 func dataSourceDNSDomainNameRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	var foundDomain *civogo.DNSDomain
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -74,7 +75,7 @@ func DataSourceDNSDomainRecord() *schema.Resource {
 }
 
 func dataSourceDNSDomainRecordRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 	domain := d.Get("domain_id").(string)
 	name := d.Get("name").(string)
 
@@ -95,8 +96,8 @@ func dataSourceDNSDomainRecordRead(_ context.Context, d *schema.ResourceData, m
 	d.Set("priority", record.Priority)
 	d.Set("ttl", record.TTL)
 	d.Set("account_id", record.AccountID)
-	d.Set("created_at", record.CreatedAt.UTC().String())
-	d.Set("updated_at", record.UpdatedAt.UTC().String())
+	d.Set("created_at", utils.FormatTime(record.CreatedAt))
+	d.Set("updated_at", utils.FormatTime(record.UpdatedAt))
 
 	return nil
 }
@@ -0,0 +1,125 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// errResolverUnreachable is returned when the resolver can't answer, as opposed to the record not resolving yet
+var errResolverUnreachable = errors.New("the DNS resolver can't be reached")
+
+// recordResolver looks up the values a DNS resolver returns for a record
+type recordResolver interface {
+	lookup(ctx context.Context, recordType, fqdn string) ([]string, error)
+}
+
+// netResolver resolves records with the Go resolver, against the given nameserver or the system one
+type netResolver struct {
+	resolver *net.Resolver
+}
+
+// newNetResolver returns a resolver which queries the nameserver (host or host:port), or the
+// resolver of the system when nameserver is empty
+func newNetResolver(nameserver string) recordResolver {
+	if nameserver == "" {
+		return &netResolver{resolver: net.DefaultResolver}
+	}
+
+	if _, _, err := net.SplitHostPort(nameserver); err != nil {
+		nameserver = net.JoinHostPort(nameserver, "53")
+	}
+
+	return &netResolver{resolver: &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			dialer := net.Dialer{}
+			return dialer.DialContext(ctx, network, nameserver)
+		},
+	}}
+}
+
+func (r *netResolver) lookup(ctx context.Context, recordType, fqdn string) ([]string, error) {
+	switch recordType {
+	case civogo.DNSRecordTypeA:
+		return r.resolver.LookupHost(ctx, fqdn)
+	case civogo.DNSRecordTypeCName:
+		cname, err := r.resolver.LookupCNAME(ctx, fqdn)
+		if err != nil {
+			return nil, err
+		}
+		return []string{cname}, nil
+	case civogo.DNSRecordTypeMX:
+		mxs, err := r.resolver.LookupMX(ctx, fqdn)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, len(mxs))
+		for i, mx := range mxs {
+			values[i] = mx.Host
+		}
+		return values, nil
+	case civogo.DNSRecordTypeTXT:
+		return r.resolver.LookupTXT(ctx, fqdn)
+	default:
+		// SRV values hold more than the target, so any answer means the record resolves
+		_, srvs, err := r.resolver.LookupSRV(ctx, "", "", fqdn)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, len(srvs))
+		for i, srv := range srvs {
+			values[i] = srv.Target
+		}
+		return values, nil
+	}
+}
+
+// recordFQDN returns the fully qualified name of a record, @ being the domain itself
+func recordFQDN(name, domain string) string {
+	if name == "@" || name == "" {
+		return domain
+	}
+	return name + "." + domain
+}
+
+// waitForPropagation polls the resolver until the record resolves to the value or the timeout expires,
+// errResolverUnreachable is returned if the resolver can't be queried at all. A pending poll never
+// returns a nil result, as StateChangeConf gives up after NotFoundChecks nil results in a row
+func waitForPropagation(ctx context.Context, resolver recordResolver, recordType, fqdn, value string, timeout, pollInterval time.Duration) error {
+	propagationStateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"propagated"},
+		Refresh: func() (interface{}, string, error) {
+			values, err := resolver.lookup(ctx, recordType, fqdn)
+			if err != nil {
+				var dnsErr *net.DNSError
+				if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+					log.Printf("[INFO] the %s record %s doesn't resolve yet", recordType, fqdn)
+					return []string{}, "pending", nil
+				}
+				return nil, "", fmt.Errorf("%w: %s", errResolverUnreachable, err)
+			}
+
+			for _, v := range values {
+				if recordValuesMatch(recordType, v, value) || recordType == civogo.DNSRecordTypeSRV {
+					return values, "propagated", nil
+				}
+			}
+
+			log.Printf("[INFO] the %s record %s resolves to %v, waiting for %s", recordType, fqdn, values, value)
+			return []string{}, "pending", nil
+		},
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+	}
+
+	_, err := propagationStateConf.WaitForStateContext(ctx)
+	return err
+}
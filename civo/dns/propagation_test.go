@@ -0,0 +1,83 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+// fakeResolver doesn't resolve the record until it was asked `resolveAfter` times
+type fakeResolver struct {
+	values       []string
+	resolveAfter int
+	err          error
+	calls        int
+}
+
+func (f *fakeResolver) lookup(_ context.Context, _, fqdn string) ([]string, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.calls < f.resolveAfter {
+		return nil, &net.DNSError{Err: "no such host", Name: fqdn, IsNotFound: true}
+	}
+	return f.values, nil
+}
+
+func TestWaitForPropagation(t *testing.T) {
+	resolver := &fakeResolver{values: []string{"www.example.com."}, resolveAfter: 3}
+
+	err := waitForPropagation(context.Background(), resolver, "CNAME", "web.example.com", "www.example.com", time.Second, time.Millisecond)
+	if err != nil {
+		t.Fatalf("expected the record to propagate, got %s", err)
+	}
+
+	if resolver.calls != 3 {
+		t.Errorf("expected the resolver to be polled until the record resolves, got %d calls", resolver.calls)
+	}
+}
+
+func TestWaitForPropagationManyPolls(t *testing.T) {
+	// StateChangeConf gives up after 20 nil results by default, a record resolving later must still be waited for
+	resolver := &fakeResolver{values: []string{"10.0.0.1"}, resolveAfter: 30}
+
+	err := waitForPropagation(context.Background(), resolver, "A", "www.example.com", "10.0.0.1", 5*time.Second, time.Millisecond)
+	if err != nil {
+		t.Fatalf("expected the record to propagate, got %s", err)
+	}
+
+	if resolver.calls != 30 {
+		t.Errorf("expected the resolver to be polled until the record resolves, got %d calls", resolver.calls)
+	}
+}
+
+func TestWaitForPropagationWrongValue(t *testing.T) {
+	resolver := &fakeResolver{values: []string{"10.0.0.2"}}
+
+	err := waitForPropagation(context.Background(), resolver, "A", "www.example.com", "10.0.0.1", 50*time.Millisecond, time.Millisecond)
+	if err == nil || errors.Is(err, errResolverUnreachable) {
+		t.Errorf("expected a timeout while the record resolves to another value, got %v", err)
+	}
+}
+
+func TestWaitForPropagationUnreachableResolver(t *testing.T) {
+	resolver := &fakeResolver{err: &net.DNSError{Err: "i/o timeout", Name: "www.example.com", IsTimeout: true}}
+
+	err := waitForPropagation(context.Background(), resolver, "A", "www.example.com", "10.0.0.1", time.Second, time.Millisecond)
+	if !errors.Is(err, errResolverUnreachable) {
+		t.Errorf("expected the resolver to be reported as unreachable, got %v", err)
+	}
+}
+
+func TestRecordFQDN(t *testing.T) {
+	if got := recordFQDN("@", "example.com"); got != "example.com" {
+		t.Errorf("expected the apex record to be the domain, got %s", got)
+	}
+
+	if got := recordFQDN("www", "example.com"); got != "www.example.com" {
+		t.Errorf("expected the record name to be prefixed to the domain, got %s", got)
+	}
+}
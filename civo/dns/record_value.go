@@ -0,0 +1,41 @@
+package dns
+
+import (
+	"net"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// normalizeHostname returns the hostname in lower case and without the trailing dot,
+// which is how the API can return a hostname configured as e.g. `Mail.Example.com.`
+func normalizeHostname(hostname string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(hostname)), ".")
+}
+
+// suppressEquivalentRecordValue avoids a diff when the value in the state and the configured
+// value are the same for the record type but written differently, the comparison used
+// depends on the type of the record
+func suppressEquivalentRecordValue(_, old, new string, d *schema.ResourceData) bool {
+	return recordValuesMatch(d.Get("type").(string), old, new)
+}
+
+// recordValuesMatch returns true if both values are the same for the type of record
+func recordValuesMatch(recordType, a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	switch recordType {
+	case civogo.DNSRecordTypeCName, civogo.DNSRecordTypeMX:
+		// hostnames are case-insensitive and the trailing dot of a fully qualified name is optional
+		return normalizeHostname(a) == normalizeHostname(b)
+	case civogo.DNSRecordTypeA:
+		ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+		return ipA != nil && ipA.Equal(ipB)
+	default:
+		// TXT and SRV values are compared as they are
+		return false
+	}
+}
@@ -0,0 +1,43 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSuppressEquivalentRecordValue(t *testing.T) {
+	cases := []struct {
+		name       string
+		recordType string
+		old        string
+		new        string
+		suppress   bool
+	}{
+		{"CNAME trailing dot", "CNAME", "www.example.com.", "www.example.com", true},
+		{"CNAME trailing dot in config", "CNAME", "www.example.com", "www.example.com.", true},
+		{"CNAME case", "CNAME", "www.example.com", "WWW.Example.COM", true},
+		{"CNAME case and trailing dot", "CNAME", "www.example.com.", "WWW.Example.com", true},
+		{"CNAME different target", "CNAME", "www.example.com", "api.example.com", false},
+		{"MX trailing dot", "MX", "mail.example.com.", "mail.example.com", true},
+		{"MX case", "MX", "mail.example.com", "Mail.Example.com", true},
+		{"MX different target", "MX", "mail.example.com.", "mx.example.com", false},
+		{"A same address", "A", "10.0.0.1", "10.0.0.1", true},
+		{"A different address", "A", "10.0.0.1", "10.0.0.2", false},
+		{"TXT case matters", "TXT", "v=spf1 -all", "V=SPF1 -ALL", false},
+		{"TXT trailing dot matters", "TXT", "value.", "value", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceDNSDomainRecord().Schema, map[string]interface{}{
+				"type":  c.recordType,
+				"value": c.new,
+			})
+
+			if got := suppressEquivalentRecordValue("value", c.old, c.new, d); got != c.suppress {
+				t.Errorf("expected %t comparing %q with %q, got %t", c.suppress, c.old, c.new, got)
+			}
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,7 +40,7 @@ func ResourceDNSDomainName() *schema.Resource {
 
 // function to create a new domain in your account
 func resourceDNSDomainNameCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	log.Printf("[INFO] Creating the domain %s", d.Get("name").(string))
 	dnsDomain, err := apiClient.CreateDNSDomain(d.Get("name").(string))
@@ -56,7 +55,7 @@ func resourceDNSDomainNameCreate(ctx context.Context, d *schema.ResourceData, m
 
 // function to read a domain from your account
 func resourceDNSDomainNameRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	log.Printf("[INFO] retriving the domain %s", d.Get("name").(string))
 	resp, err := apiClient.GetDNSDomain(d.Get("name").(string))
@@ -77,7 +76,7 @@ func resourceDNSDomainNameRead(_ context.Context, d *schema.ResourceData, m inte
 
 // function to update a specific domain
 func resourceDNSDomainNameUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	log.Printf("[INFO] Searching the domain %s", d.Get("name").(string))
 	resp, err := apiClient.FindDNSDomain(d.Id())
@@ -102,7 +101,7 @@ func resourceDNSDomainNameUpdate(ctx context.Context, d *schema.ResourceData, m
 
 // function to delete a specific domain
 func resourceDNSDomainNameDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	log.Printf("[INFO] Searching the domain to %s", d.Get("name").(string))
 	resp, err := apiClient.FindDNSDomain(d.Id())
@@ -122,7 +121,7 @@ func resourceDNSDomainNameDelete(_ context.Context, d *schema.ResourceData, m in
 
 // custom import to able add a main domain to the terraform
 func resourceDNSDomainImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	log.Printf("[INFO] Searching the domain %s", d.Id())
 	resp, err := apiClient.GetDNSDomain(d.Id())
@@ -2,8 +2,11 @@ package dns
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
@@ -40,10 +43,11 @@ func ResourceDNSDomainRecord() *schema.Resource {
 				Description: "The portion before the domain name (e.g. www) or an @ for the apex/root domain (you cannot use an A record with an amex/root domain)",
 			},
 			"value": {
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "The IP address (A or MX), hostname (CNAME or MX) or text value (TXT) to serve for this record",
-				ValidateFunc: validation.NoZeroValues,
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The IP address (A or MX), hostname (CNAME or MX) or text value (TXT) to serve for this record. Hostnames are compared case-insensitively and with or without a trailing dot, so an equivalent value returned by the API doesn't show a diff",
+				ValidateFunc:     validation.NoZeroValues,
+				DiffSuppressFunc: suppressEquivalentRecordValue,
 			},
 			"priority": {
 				Type:         schema.TypeInt,
@@ -57,6 +61,23 @@ func ResourceDNSDomainRecord() *schema.Resource {
 				ValidateFunc: validation.IntBetween(600, 3600),
 				Description:  "How long caching DNS servers should cache this record for, in seconds (the minimum is 600 and the default if unspecified is 600)",
 			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "An optional list of tags for the record. The Civo API doesn't support tags on DNS records, so they are only kept in the Terraform state",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_propagation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait until the record resolves to its value after it is created or updated, up to the create or update timeout. If the resolver can't be reached a warning is shown and the wait is skipped (default: false)",
+			},
+			"propagation_nameserver": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The nameserver (`host` or `host:port`) used to check the propagation of the record, the resolver of the system is used if not set",
+			},
 			// Computed resource
 			"account_id": {
 				Type:        schema.TypeString,
@@ -82,12 +103,16 @@ func ResourceDNSDomainRecord() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceDNSDomainRecordImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 	}
 }
 
 // function to create a new record for the main domain
 func resourceDNSDomainRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	log.Printf("[INFO] configuring the domain record %s", d.Get("name").(string))
 	config := &civogo.DNSRecordConfig{
@@ -131,12 +156,17 @@ func resourceDNSDomainRecordCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(dnsDomainRecord.ID)
 
-	return resourceDNSDomainRecordRead(ctx, d, m)
+	diags := waitForRecordPropagation(ctx, d, apiClient, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceDNSDomainRecordRead(ctx, d, m)...)
 }
 
 // function to read a dns domain record
 func resourceDNSDomainRecordRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	log.Printf("[INFO] retriving the domain record %s", d.Get("name").(string))
 	resp, err := apiClient.GetDNSRecord(d.Get("domain_id").(string), d.Id())
@@ -157,15 +187,26 @@ func resourceDNSDomainRecordRead(_ context.Context, d *schema.ResourceData, m in
 	d.Set("type", strings.ToUpper(string(resp.Type)))
 	d.Set("priority", resp.Priority)
 	d.Set("ttl", resp.TTL)
-	d.Set("created_at", resp.CreatedAt.UTC().String())
-	d.Set("updated_at", resp.UpdatedAt.UTC().String())
+	d.Set("created_at", utils.FormatTime(resp.CreatedAt))
+	d.Set("updated_at", utils.FormatTime(resp.UpdatedAt))
+
+	// wait_for_propagation is missing from the state of the records created before it was added,
+	// set its default so upgrading the provider plans no change
+	if _, ok := d.GetOkExists("wait_for_propagation"); !ok {
+		d.Set("wait_for_propagation", false)
+	}
 
 	return nil
 }
 
 // function to update a dns domain record
 func resourceDNSDomainRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
+
+	// the tags are only kept in the state, so there is nothing to send to the API
+	if !d.HasChangesExcept("tags") {
+		return resourceDNSDomainRecordRead(ctx, d, m)
+	}
 
 	resp, err := apiClient.GetDNSRecord(d.Get("domain_id").(string), d.Id())
 	if err != nil {
@@ -207,12 +248,51 @@ func resourceDNSDomainRecordUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("[ERR] an error occurred while renamed the domain record %s, %s", d.Id(), err)
 	}
 
-	return resourceDNSDomainRecordRead(ctx, d, m)
+	var diags diag.Diagnostics
+	if d.HasChanges("name", "value", "type") {
+		diags = waitForRecordPropagation(ctx, d, apiClient, d.Timeout(schema.TimeoutUpdate))
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, resourceDNSDomainRecordRead(ctx, d, m)...)
+}
+
+// waitForRecordPropagation waits for the record to resolve if wait_for_propagation is set, a resolver
+// which can't be reached only produces a warning
+func waitForRecordPropagation(ctx context.Context, d *schema.ResourceData, apiClient *civogo.Client, timeout time.Duration) diag.Diagnostics {
+	if !d.Get("wait_for_propagation").(bool) {
+		return nil
+	}
+
+	domain, err := apiClient.FindDNSDomain(d.Get("domain_id").(string))
+	if err != nil {
+		return diag.Errorf("[ERR] failed to find the domain of the record %s: %s", d.Id(), err)
+	}
+
+	fqdn := recordFQDN(d.Get("name").(string), domain.Name)
+	resolver := newNetResolver(d.Get("propagation_nameserver").(string))
+
+	log.Printf("[INFO] waiting for the record %s to propagate", fqdn)
+	err = waitForPropagation(ctx, resolver, d.Get("type").(string), fqdn, d.Get("value").(string), timeout, 10*time.Second)
+	if err != nil {
+		if errors.Is(err, errResolverUnreachable) {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "Unable to check the propagation of the DNS record",
+				Detail:   fmt.Sprintf("The record %s was saved but its propagation couldn't be checked: %s", fqdn, err),
+			}}
+		}
+		return diag.Errorf("[ERR] error waiting for the record %s to propagate: %s", fqdn, err)
+	}
+
+	return nil
 }
 
 // function to delete a dns domain record
 func resourceDNSDomainRecordDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	log.Printf("[INFO] Searching the domain record %s", d.Get("name").(string))
 	resp, err := apiClient.GetDNSRecord(d.Get("domain_id").(string), d.Id())
@@ -231,7 +311,7 @@ func resourceDNSDomainRecordDelete(_ context.Context, d *schema.ResourceData, m
 
 // custom import to able to add a main domain to the terraform
 func resourceDNSDomainRecordImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	domainID, DomainRecordID, err := utils.ResourceCommonParseID(d.Id())
 	if err != nil {
@@ -255,8 +335,9 @@ func resourceDNSDomainRecordImport(d *schema.ResourceData, m interface{}) ([]*sc
 	d.Set("type", resp.Type)
 	d.Set("priority", resp.Priority)
 	d.Set("ttl", resp.TTL)
-	d.Set("created_at", resp.CreatedAt.UTC().String())
-	d.Set("updated_at", resp.UpdatedAt.UTC().String())
+	d.Set("created_at", utils.FormatTime(resp.CreatedAt))
+	d.Set("updated_at", utils.FormatTime(resp.UpdatedAt))
+	d.Set("wait_for_propagation", false)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,42 @@
+package dns
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceDNSDomainRecordReadDefaultsMissingAttributes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v2/dns/domain-id/records" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write([]byte(`[{"id": "record-id", "domain_id": "domain-id", "name": "www", "value": "10.0.0.1", "type": "A", "ttl": 600}]`))
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	// the state of a record created before wait_for_propagation was added
+	d := ResourceDNSDomainRecord().Data(&terraform.InstanceState{
+		ID:         "record-id",
+		Attributes: map[string]string{"id": "record-id", "domain_id": "domain-id", "name": "www"},
+	})
+
+	if diags := resourceDNSDomainRecordRead(context.Background(), d, &utils.CombinedConfig{Client: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if _, ok := d.GetOkExists("wait_for_propagation"); !ok {
+		t.Errorf("expected wait_for_propagation to be set")
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -77,6 +78,53 @@ func TestAccCivoDNSDomainNameRecord_update(t *testing.T) {
 	})
 }
 
+// TestAccCivoDNSDomainNameRecord_tags tests that the tags, which are only kept in the state, round-trip
+func TestAccCivoDNSDomainNameRecord_tags(t *testing.T) {
+	var domainRecord civogo.DNSRecord
+
+	// generate a random name for each test run
+	resName := "civo_dns_domain_record.www"
+	var domainName = acctest.RandomWithPrefix("tf-test-record-tags") + ".example"
+	var recordName = acctest.RandomWithPrefix("record")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoDNSDomainNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoDNSDomainNameRecordConfigTags(domainName, recordName, `["web", "production"]`),
+				Check: resource.ComposeTestCheckFunc(
+					CivoDNSDomainNameRecordResourceExists(resName, &domainRecord),
+					resource.TestCheckResourceAttr(resName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "web"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "production"),
+				),
+			},
+			{
+				Config: CivoDNSDomainNameRecordConfigTags(domainName, recordName, `["web"]`),
+				Check: resource.ComposeTestCheckFunc(
+					CivoDNSDomainNameRecordResourceExists(resName, &domainRecord),
+					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "web"),
+				),
+			},
+			{
+				Config: CivoDNSDomainNameRecordConfigTags(domainName, recordName, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					CivoDNSDomainNameRecordResourceExists(resName, &domainRecord),
+					resource.TestCheckResourceAttr(resName, "tags.#", "0"),
+				),
+			},
+			{
+				// an empty list and no tags at all must not produce a diff
+				Config:   CivoDNSDomainNameRecordConfigBasic(domainName, recordName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func CivoDNSDomainNameRecordValues(domainRecord *civogo.DNSRecord, name string) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if domainRecord.Name != name {
@@ -95,7 +143,7 @@ func CivoDNSDomainNameRecordResourceExists(n string, domainRecord *civogo.DNSRec
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 		resp, err := client.GetDNSRecord(rs.Primary.Attributes["domain_id"], rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Domain record not found: (%s) %s", rs.Primary.ID, err)
@@ -119,7 +167,7 @@ func CivoDNSDomainNameRecordUpdated(domainRecord *civogo.DNSRecord, name string)
 }
 
 func CivoDNSDomainNameRecordDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_dns_domain_record" {
@@ -166,3 +214,20 @@ resource "civo_dns_domain_record" "www" {
 }
 `, domain, record)
 }
+
+func CivoDNSDomainNameRecordConfigTags(domain string, record string, tags string) string {
+	return fmt.Sprintf(`
+resource "civo_dns_domain_name" "foobar" {
+	name = "%s"
+}
+
+resource "civo_dns_domain_record" "www" {
+    domain_id = civo_dns_domain_name.foobar.id
+    type = "A"
+    name = "%s"
+    value = "10.10.10.1"
+    ttl = 600
+    tags = %s
+}
+`, domain, record, tags)
+}
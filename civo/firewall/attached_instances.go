@@ -0,0 +1,48 @@
+package firewall
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// attachedInstances returns the sorted IDs of the instances using the firewall, the API doesn't
+// link firewalls to their instances so every instance of the region is scanned
+func attachedInstances(apiClient *civogo.Client, firewallID string) ([]string, error) {
+	instances, err := apiClient.ListAllInstances()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, instance := range instances {
+		if instance.FirewallID == firewallID {
+			ids = append(ids, instance.ID)
+		}
+	}
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+// setAttachedInstances sets the instances using the firewall on the firewall resource or data source.
+// The scan depends on the instance API, so when it fails the firewall is still read, the attached
+// instances keep their previous values and a warning is returned
+func setAttachedInstances(d *schema.ResourceData, apiClient *civogo.Client, firewallID string) diag.Diagnostics {
+	instances, err := attachedInstances(apiClient, firewallID)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unable to list the instances using the firewall",
+			Detail:   fmt.Sprintf("The attached instances of the firewall %s weren't refreshed: %s", firewallID, err),
+		}}
+	}
+
+	d.Set("attached_instances", instances)
+	d.Set("instance_count", len(instances))
+
+	return nil
+}
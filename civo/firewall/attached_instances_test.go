@@ -0,0 +1,140 @@
+package firewall
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func fakeInstancesServer(t *testing.T, instancesJSON string) (*civogo.Client, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v2/instances" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": ` + instancesJSON + `}`))
+	}))
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	return client, server
+}
+
+func TestAttachedInstances(t *testing.T) {
+	client, server := fakeInstancesServer(t, `[
+		{"id": "web-2", "hostname": "web-2", "firewall_id": "fw-1"},
+		{"id": "db-1", "hostname": "db-1", "firewall_id": "fw-2"},
+		{"id": "web-1", "hostname": "web-1", "firewall_id": "fw-1"}
+	]`)
+	defer server.Close()
+
+	got, err := attachedInstances(client, "fw-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{"web-1", "web-2"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestAttachedInstancesNone(t *testing.T) {
+	client, server := fakeInstancesServer(t, `[]`)
+	defer server.Close()
+
+	got, err := attachedInstances(client, "fw-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list, got %#v", got)
+	}
+}
+
+// fakeFirewallServer serves the firewall fw-1 while the instance API fails
+func fakeFirewallServer(t *testing.T) (*civogo.Client, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v2/firewalls" {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		rw.Write([]byte(`[{"id": "fw-1", "name": "renamed"}]`))
+	}))
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	return client, server
+}
+
+func TestResourceFirewallReadAttachedInstancesUnavailable(t *testing.T) {
+	client, server := fakeFirewallServer(t)
+	defer server.Close()
+
+	d := ResourceFirewall().Data(&terraform.InstanceState{
+		ID: "fw-1",
+		Attributes: map[string]string{
+			"id":                   "fw-1",
+			"name":                 "web",
+			"attached_instances.#": "1",
+			"attached_instances.0": "web-1",
+			"instance_count":       "1",
+		},
+	})
+
+	diags := resourceFirewallRead(context.Background(), d, &utils.CombinedConfig{Client: client})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a warning, got %v", diags)
+	}
+
+	if got := d.Get("name").(string); got != "renamed" {
+		t.Errorf("expected the firewall to be read, got the name %q", got)
+	}
+	if got := d.Get("attached_instances").([]interface{}); !reflect.DeepEqual(got, []interface{}{"web-1"}) {
+		t.Errorf("expected the previous instances to be kept, got %v", got)
+	}
+	if got := d.Get("instance_count").(int); got != 1 {
+		t.Errorf("expected the previous count to be kept, got %d", got)
+	}
+}
+
+func TestDataSourceFirewallReadAttachedInstancesUnavailable(t *testing.T) {
+	client, server := fakeFirewallServer(t)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, DataSourceFirewall().Schema, map[string]interface{}{"id": "fw-1"})
+
+	diags := dataSourceFirewallRead(context.Background(), d, &utils.CombinedConfig{Client: client})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a warning, got %v", diags)
+	}
+
+	if d.Id() != "fw-1" || d.Get("name").(string) != "renamed" {
+		t.Errorf("expected the firewall to be read, got %q named %q", d.Id(), d.Get("name"))
+	}
+}
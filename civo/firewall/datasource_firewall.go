@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -47,17 +48,29 @@ func DataSourceFirewall() *schema.Resource {
 				Computed:    true,
 				Description: "The id of the associated network",
 			},
+			"attached_instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the instances currently using the firewall",
+			},
+			"instance_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of instances currently using the firewall",
+			},
 		},
 	}
 }
 
 func dataSourceFirewallRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	config := m.(*utils.CombinedConfig)
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	region, err := utils.ResolveRegion(d.Get("region").(string), config)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	apiClient := utils.ClientForRegion(config, region)
 
 	var foundFirewall *civogo.Firewall
 
@@ -84,5 +97,5 @@ func dataSourceFirewallRead(_ context.Context, d *schema.ResourceData, m interfa
 	d.Set("network_id", foundFirewall.NetworkID)
 	d.Set("region", apiClient.Region)
 
-	return nil
+	return setAttachedInstances(d, apiClient, foundFirewall.ID)
 }
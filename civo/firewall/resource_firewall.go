@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/civo/civogo"
@@ -40,6 +43,11 @@ func ResourceFirewall() *schema.Resource {
 				Computed:    true,
 				Description: "The firewall region, if is not defined we use the global defined in the provider",
 			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uniform resource name (URN) of the firewall, in the form `civo:firewall:<region>:<id>`",
+			},
 			"create_default_rules": {
 				Type:        schema.TypeBool,
 				Default:     true,
@@ -61,6 +69,17 @@ func ResourceFirewall() *schema.Resource {
 				Elem:        firewallRuleSchema(),
 				Description: "The egress rules, this is a list of rules that will be applied to the firewall",
 			},
+			"attached_instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the instances currently using the firewall",
+			},
+			"instance_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of instances currently using the firewall",
+			},
 		},
 		CreateContext: resourceFirewallCreate,
 		ReadContext:   resourceFirewallRead,
@@ -115,12 +134,7 @@ func ResourceFirewall() *schema.Resource {
 
 // function to create a firewall
 func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	createDefaultRules := d.Get("create_default_rules").(bool)
 
@@ -151,16 +165,17 @@ func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, m inter
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{"failed"},
 		Target:  []string{"success"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("firewall %s", firewallConfig.Name), func() (interface{}, string, error) {
 			resp, err := apiClient.NewFirewall(firewallConfig)
 			if err != nil {
 				return 0, "", err
 			}
 			return resp, string(resp.Result), nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 10,
 	}
 	_, err = createStateConf.WaitForStateContext(context.Background())
@@ -181,12 +196,7 @@ func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 // function to read a firewall
 func resourceFirewallRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] retriving the firewall %s", d.Id())
 	resp, err := apiClient.FindFirewall(d.Id())
@@ -201,8 +211,11 @@ func resourceFirewallRead(_ context.Context, d *schema.ResourceData, m interface
 	d.Set("name", resp.Name)
 	d.Set("network_id", resp.NetworkID)
 	d.Set("region", apiClient.Region)
+	d.Set("urn", utils.URN("firewall", apiClient.Region, d.Id()))
 	d.Set("create_default_rules", d.Get("create_default_rules").(bool))
 
+	diags := setAttachedInstances(d, apiClient, resp.ID)
+
 	for _, rule := range resp.Rules {
 		if rule.Direction == "ingress" {
 			if err := d.Set("ingress_rule", flattenFirewallRules(resp.Rules, rule.Direction)); err != nil {
@@ -215,17 +228,12 @@ func resourceFirewallRead(_ context.Context, d *schema.ResourceData, m interface
 		}
 	}
 
-	return nil
+	return diags
 }
 
 // function to update the firewall
 func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	if d.HasChange("name") {
 		if d.Get("name").(string) != "" {
@@ -312,13 +320,8 @@ func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 // function to delete a firewall
-func resourceFirewallDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceFirewallDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	firewallID := d.Id()
 	log.Printf("[INFO] Checking if firewall %s exists", firewallID)
@@ -333,16 +336,17 @@ func resourceFirewallDelete(_ context.Context, d *schema.ResourceData, m interfa
 	deleteStateConf := &retry.StateChangeConf{
 		Pending: []string{"failed"},
 		Target:  []string{"success"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("firewall %s", firewallID), func() (interface{}, string, error) {
 			resp, err := apiClient.DeleteFirewall(firewallID)
 			if err != nil {
 				return 0, "", err
 			}
 			return resp, string(resp.Result), nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 10,
 	}
 	_, err = deleteStateConf.WaitForStateContext(context.Background())
@@ -533,6 +537,43 @@ func flattenFirewallRules(rules []civogo.FirewallRule, direction string) []inter
 	return flattenedRules
 }
 
+// SortFirewallRules sorts the rules by direction, protocol, ports, cidr and then ID, so lists
+// built from them, like the effective_firewall_rules of an instance, keep the same order whatever
+// order the API returns them in. The ingress_rule and egress_rule sets don't need it
+func SortFirewallRules(rules []civogo.FirewallRule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		a, b := rules[i], rules[j]
+		if a.Direction != b.Direction {
+			return a.Direction < b.Direction
+		}
+		if a.Protocol != b.Protocol {
+			return a.Protocol < b.Protocol
+		}
+
+		aPort, _ := strconv.Atoi(a.StartPort)
+		bPort, _ := strconv.Atoi(b.StartPort)
+		if aPort != bPort {
+			return aPort < bPort
+		}
+		if a.Ports != b.Ports {
+			return a.Ports < b.Ports
+		}
+
+		if sortedCIDR(a.Cidr) != sortedCIDR(b.Cidr) {
+			return sortedCIDR(a.Cidr) < sortedCIDR(b.Cidr)
+		}
+
+		return a.ID < b.ID
+	})
+}
+
+// sortedCIDR returns the cidr list as a comparable string, regardless of the order of the list
+func sortedCIDR(cidr []string) string {
+	sorted := append([]string(nil), cidr...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
 func flattenFirewallRuleCIDR(strings []string) *schema.Set {
 	flattenedStrings := schema.NewSet(schema.HashString, []interface{}{})
 	for _, v := range strings {
@@ -0,0 +1,303 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The presets a civo_firewall_rule_set can apply to a firewall
+const (
+	// FirewallRulePresetWeb opens HTTP and HTTPS to everyone
+	FirewallRulePresetWeb = "web"
+	// FirewallRulePresetSSHOnly opens SSH to everyone
+	FirewallRulePresetSSHOnly = "ssh-only"
+	// FirewallRulePresetKubernetes opens the Kubernetes API, HTTP and HTTPS to everyone
+	FirewallRulePresetKubernetes = "kubernetes"
+)
+
+// firewallRulePresets maps every preset to the rules it expands to
+var firewallRulePresets = map[string][]civogo.FirewallRuleConfig{
+	FirewallRulePresetWeb: {
+		{Label: "web: HTTP", Protocol: "tcp", Ports: "80", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+		{Label: "web: HTTPS", Protocol: "tcp", Ports: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+	},
+	FirewallRulePresetSSHOnly: {
+		{Label: "ssh-only: SSH", Protocol: "tcp", Ports: "22", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+	},
+	FirewallRulePresetKubernetes: {
+		{Label: "kubernetes: API", Protocol: "tcp", Ports: "6443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+		{Label: "kubernetes: HTTP", Protocol: "tcp", Ports: "80", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+		{Label: "kubernetes: HTTPS", Protocol: "tcp", Ports: "443", Cidr: []string{"0.0.0.0/0"}, Direction: "ingress", Action: "allow"},
+	},
+}
+
+// firewallRulePresetNames returns the names of all the presets, sorted
+func firewallRulePresetNames() []string {
+	names := make([]string, 0, len(firewallRulePresets))
+	for name := range firewallRulePresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ResourceFirewallRuleSet applies a named preset of common rules to a firewall
+func ResourceFirewallRuleSet() *schema.Resource {
+	return &schema.Resource{
+		Description: "Applies a named preset of common rules to a Civo firewall, so they don't have to be written out by hand.",
+		Schema: map[string]*schema.Schema{
+			"firewall_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the firewall to apply the rules to",
+			},
+			"preset": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(firewallRulePresetNames(), false),
+				Description:  "The preset of rules to apply, one of " + utils.GetCommaSeparatedAllowedKeys(firewallRulePresetNames()),
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The region of the firewall, if not declared we use the region declared in the provider",
+			},
+			"rule_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the firewall rules created for the preset",
+			},
+		},
+		CreateContext: resourceFirewallRuleSetCreate,
+		ReadContext:   resourceFirewallRuleSetRead,
+		UpdateContext: resourceFirewallRuleSetUpdate,
+		DeleteContext: resourceFirewallRuleSetDelete,
+	}
+}
+
+// function to create a firewall rule set
+func resourceFirewallRuleSetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
+
+	firewallID := d.Get("firewall_id").(string)
+	preset := d.Get("preset").(string)
+
+	log.Printf("[INFO] applying the %s preset to the firewall %s", preset, firewallID)
+	ruleIDs, err := applyFirewallRulePreset(apiClient, firewallID, preset, nil)
+	if err != nil {
+		// keep the rules created before the failure in the state, so they aren't orphaned
+		if len(ruleIDs) > 0 {
+			d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", firewallID)))
+			d.Set("rule_ids", ruleIDs)
+		}
+		return diag.Errorf("[ERR] failed to apply the %s preset to the firewall %s: %s", preset, firewallID, err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", firewallID)))
+	d.Set("rule_ids", ruleIDs)
+
+	return resourceFirewallRuleSetRead(ctx, d, m)
+}
+
+// function to read a firewall rule set
+func resourceFirewallRuleSetRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
+
+	firewallID := d.Get("firewall_id").(string)
+
+	log.Printf("[INFO] retrieving the firewall %s", firewallID)
+	resp, err := apiClient.FindFirewall(firewallID)
+	if err != nil {
+		if resp == nil {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERR] error retrieving firewall: %s", err)
+	}
+
+	rules, err := apiClient.ListFirewallRules(firewallID)
+	if err != nil {
+		return diag.Errorf("[ERR] failed to list the rules of the firewall %s: %s", firewallID, err)
+	}
+
+	owned := ownedFirewallRules(rules, d.Get("rule_ids").([]interface{}))
+	ruleIDs := make([]string, len(owned))
+	for i, rule := range owned {
+		ruleIDs[i] = rule.ID
+	}
+	d.Set("rule_ids", ruleIDs)
+
+	// a rule of the preset was removed outside of terraform, clear the preset so the
+	// plan shows a diff and the update puts the missing rules back
+	preset := d.Get("preset").(string)
+	if !firewallRulesCoverPreset(owned, preset) {
+		log.Printf("[WARN] the rules of the %s preset are missing from the firewall %s", preset, firewallID)
+		d.Set("preset", "")
+	}
+
+	return nil
+}
+
+// function to update a firewall rule set
+func resourceFirewallRuleSetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
+
+	if d.HasChange("preset") {
+		firewallID := d.Get("firewall_id").(string)
+		preset := d.Get("preset").(string)
+
+		log.Printf("[INFO] applying the %s preset to the firewall %s", preset, firewallID)
+		ruleIDs, err := applyFirewallRulePreset(apiClient, firewallID, preset, d.Get("rule_ids").([]interface{}))
+		if err != nil {
+			if ruleIDs != nil {
+				d.Set("rule_ids", ruleIDs)
+			}
+			return diag.Errorf("[ERR] failed to apply the %s preset to the firewall %s: %s", preset, firewallID, err)
+		}
+		d.Set("rule_ids", ruleIDs)
+	}
+
+	return resourceFirewallRuleSetRead(ctx, d, m)
+}
+
+// function to delete a firewall rule set
+func resourceFirewallRuleSetDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
+
+	firewallID := d.Get("firewall_id").(string)
+	_, err := apiClient.FindFirewall(firewallID)
+	if err != nil {
+		log.Printf("[INFO] Unable to find firewall %s - probably it's been deleted", firewallID)
+		return nil
+	}
+
+	for _, ruleID := range d.Get("rule_ids").([]interface{}) {
+		log.Printf("[INFO] deleting the rule %s from the firewall %s", ruleID, firewallID)
+		_, err := apiClient.DeleteFirewallRule(firewallID, ruleID.(string))
+		if err != nil {
+			return diag.Errorf("[ERR] an error occurred while trying to delete the rule %s, %s", ruleID, err)
+		}
+	}
+
+	return nil
+}
+
+// applyFirewallRulePreset makes the rules owned by the rule set, the ones in ruleIDs, match the
+// preset. Owned rules which are already part of the preset are kept, the others are deleted, and
+// the missing rules of the preset are created. It returns the IDs of the rules the set owns now,
+// also when it fails half way, so the rules created or not deleted yet stay owned by the set
+func applyFirewallRulePreset(apiClient *civogo.Client, firewallID, preset string, ruleIDs []interface{}) ([]string, error) {
+	rules, err := apiClient.ListFirewallRules(firewallID)
+	if err != nil {
+		return nil, err
+	}
+	owned := ownedFirewallRules(rules, ruleIDs)
+
+	kept := map[string]bool{}
+	newRuleIDs := []string{}
+	// ownedSoFar returns the rules the set owns when applying the preset stops: the ones kept or
+	// created so far, and the other owned rules, from the index from on, which aren't deleted yet
+	ownedSoFar := func(from int) []string {
+		ids := append([]string{}, newRuleIDs...)
+		for _, rule := range owned[from:] {
+			if !kept[rule.ID] {
+				ids = append(ids, rule.ID)
+			}
+		}
+		return ids
+	}
+
+	for _, want := range firewallRulePresets[preset] {
+		found := ""
+		for _, rule := range owned {
+			if !kept[rule.ID] && firewallRuleMatches(rule, want) {
+				found = rule.ID
+				break
+			}
+		}
+
+		if found == "" {
+			want.FirewallID = firewallID
+			resp, err := apiClient.NewFirewallRule(&want)
+			if err != nil {
+				return ownedSoFar(0), err
+			}
+			log.Printf("[INFO] created the rule %s for the %s preset", resp.ID, preset)
+			found = resp.ID
+		}
+
+		kept[found] = true
+		newRuleIDs = append(newRuleIDs, found)
+	}
+
+	for i, rule := range owned {
+		if kept[rule.ID] {
+			continue
+		}
+		log.Printf("[INFO] removing the rule %s, it isn't part of the %s preset", rule.ID, preset)
+		if _, err := apiClient.DeleteFirewallRule(firewallID, rule.ID); err != nil {
+			return ownedSoFar(i), err
+		}
+	}
+
+	return newRuleIDs, nil
+}
+
+// ownedFirewallRules returns the rules of the firewall whose IDs are in ruleIDs, in the order of ruleIDs
+func ownedFirewallRules(rules []civogo.FirewallRule, ruleIDs []interface{}) []civogo.FirewallRule {
+	owned := []civogo.FirewallRule{}
+	for _, ruleID := range ruleIDs {
+		for _, rule := range rules {
+			if rule.ID == ruleID.(string) {
+				owned = append(owned, rule)
+				break
+			}
+		}
+	}
+	return owned
+}
+
+// firewallRulesCoverPreset returns whether every rule of the preset has a matching rule
+func firewallRulesCoverPreset(rules []civogo.FirewallRule, preset string) bool {
+	want := firewallRulePresets[preset]
+	if len(rules) != len(want) {
+		return false
+	}
+
+	for i := range want {
+		if !firewallRuleMatches(rules[i], want[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// firewallRuleMatches returns whether the rule of the firewall is the rule of the preset, the label is ignored
+func firewallRuleMatches(rule civogo.FirewallRule, want civogo.FirewallRuleConfig) bool {
+	ports := rule.Ports
+	if ports == "" {
+		ports = rule.StartPort
+		if rule.EndPort != "" && rule.EndPort != rule.StartPort {
+			ports = fmt.Sprintf("%s-%s", rule.StartPort, rule.EndPort)
+		}
+	}
+
+	return rule.Direction == want.Direction &&
+		rule.Protocol == want.Protocol &&
+		rule.Action == want.Action &&
+		ports == want.Ports &&
+		sortedCIDR(rule.Cidr) == sortedCIDR(want.Cidr)
+}
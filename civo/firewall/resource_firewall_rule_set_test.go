@@ -0,0 +1,251 @@
+package firewall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fakeFirewallRulesServer keeps the rules of the firewall fw-1 in memory, failPost makes the
+// POST with that number fail
+type fakeFirewallRulesServer struct {
+	mu       sync.Mutex
+	rules    []civogo.FirewallRule
+	nextID   int
+	posts    int
+	failPost int
+}
+
+func (f *fakeFirewallRulesServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case req.URL.Path == "/v2/firewalls":
+		rw.Write([]byte(`[{"id": "fw-1", "name": "web"}]`))
+	case req.URL.Path == "/v2/firewalls/fw-1/rules" && req.Method == http.MethodGet:
+		json.NewEncoder(rw).Encode(f.rules)
+	case req.URL.Path == "/v2/firewalls/fw-1/rules" && req.Method == http.MethodPost:
+		f.posts++
+		if f.posts == f.failPost {
+			rw.WriteHeader(http.StatusInternalServerError)
+			rw.Write([]byte(`{"code": "database_firewall_rule_create_failed"}`))
+			return
+		}
+		var rule civogo.FirewallRule
+		json.NewDecoder(req.Body).Decode(&rule)
+		f.nextID++
+		rule.ID = fmt.Sprintf("rule-%d", f.nextID)
+		f.rules = append(f.rules, rule)
+		json.NewEncoder(rw).Encode(rule)
+	case strings.HasPrefix(req.URL.Path, "/v2/firewalls/fw-1/rules/") && req.Method == http.MethodDelete:
+		f.deleteRule(strings.TrimPrefix(req.URL.Path, "/v2/firewalls/fw-1/rules/"))
+		rw.Write([]byte(`{"result": "success"}`))
+	default:
+		rw.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeFirewallRulesServer) deleteRule(id string) {
+	for i, rule := range f.rules {
+		if rule.ID == id {
+			f.rules = append(f.rules[:i], f.rules[i+1:]...)
+			return
+		}
+	}
+}
+
+func (f *fakeFirewallRulesServer) ports() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ports := []string{}
+	for _, rule := range f.rules {
+		ports = append(ports, rule.Ports)
+	}
+	return ports
+}
+
+func newFakeFirewallRulesConfig(t *testing.T, fake *fakeFirewallRulesServer) (*utils.CombinedConfig, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(fake)
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	return &utils.CombinedConfig{Client: client}, server
+}
+
+func TestResourceFirewallRuleSetApplyAndUpdate(t *testing.T) {
+	// a rule the rule set doesn't own must be left alone
+	fake := &fakeFirewallRulesServer{rules: []civogo.FirewallRule{
+		{ID: "mine", Direction: "ingress", Protocol: "tcp", Ports: "8080", Cidr: []string{"10.0.0.0/8"}, Action: "allow"},
+	}}
+	config, server := newFakeFirewallRulesConfig(t, fake)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceFirewallRuleSet().Schema, map[string]interface{}{
+		"firewall_id": "fw-1",
+		"preset":      FirewallRulePresetWeb,
+	})
+
+	if diags := resourceFirewallRuleSetCreate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := fake.ports(); strings.Join(got, ",") != "8080,80,443" {
+		t.Fatalf("expected the web rules next to the existing rule, got %v", got)
+	}
+	if got := d.Get("rule_ids").([]interface{}); len(got) != 2 {
+		t.Fatalf("expected 2 rules owned by the rule set, got %v", got)
+	}
+
+	// kubernetes keeps the HTTP and HTTPS rules and only adds the API rule
+	d.Set("preset", FirewallRulePresetKubernetes)
+	if diags := resourceFirewallRuleSetUpdate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := fake.ports(); strings.Join(got, ",") != "8080,80,443,6443" {
+		t.Fatalf("expected the kubernetes rules next to the existing rule, got %v", got)
+	}
+	if got := d.Get("rule_ids").([]interface{}); len(got) != 3 || got[1] != "rule-1" || got[2] != "rule-2" {
+		t.Fatalf("expected the HTTP and HTTPS rules to be kept, got %v", got)
+	}
+
+	d.Set("preset", FirewallRulePresetSSHOnly)
+	if diags := resourceFirewallRuleSetUpdate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := fake.ports(); strings.Join(got, ",") != "8080,22" {
+		t.Fatalf("expected only the ssh rule next to the existing rule, got %v", got)
+	}
+
+	if diags := resourceFirewallRuleSetDelete(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := fake.ports(); strings.Join(got, ",") != "8080" {
+		t.Fatalf("expected only the existing rule to be left, got %v", got)
+	}
+}
+
+func TestResourceFirewallRuleSetReadDrift(t *testing.T) {
+	fake := &fakeFirewallRulesServer{}
+	config, server := newFakeFirewallRulesConfig(t, fake)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceFirewallRuleSet().Schema, map[string]interface{}{
+		"firewall_id": "fw-1",
+		"preset":      FirewallRulePresetWeb,
+	})
+
+	if diags := resourceFirewallRuleSetCreate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("preset").(string); got != FirewallRulePresetWeb {
+		t.Fatalf("expected the preset to be kept while all its rules exist, got %q", got)
+	}
+
+	// the HTTPS rule is removed outside of terraform
+	fake.mu.Lock()
+	fake.deleteRule("rule-2")
+	fake.mu.Unlock()
+
+	if diags := resourceFirewallRuleSetRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("preset").(string); got != "" {
+		t.Errorf("expected the preset to be cleared when one of its rules is missing, got %q", got)
+	}
+	if got := d.Get("rule_ids").([]interface{}); len(got) != 1 || got[0] != "rule-1" {
+		t.Errorf("expected only the remaining rule, got %v", got)
+	}
+
+	// applying the preset again only puts the missing rule back
+	d.Set("preset", FirewallRulePresetWeb)
+	if diags := resourceFirewallRuleSetUpdate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := fake.ports(); strings.Join(got, ",") != "80,443" {
+		t.Errorf("expected the HTTPS rule to be recreated, got %v", got)
+	}
+	if got := d.Get("preset").(string); got != FirewallRulePresetWeb {
+		t.Errorf("expected the preset to be set again, got %q", got)
+	}
+}
+
+func TestResourceFirewallRuleSetCreatePartialFailure(t *testing.T) {
+	fake := &fakeFirewallRulesServer{failPost: 2}
+	config, server := newFakeFirewallRulesConfig(t, fake)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceFirewallRuleSet().Schema, map[string]interface{}{
+		"firewall_id": "fw-1",
+		"preset":      FirewallRulePresetWeb,
+	})
+
+	if diags := resourceFirewallRuleSetCreate(context.Background(), d, config); !diags.HasError() {
+		t.Fatal("expected the failed POST to be reported")
+	}
+
+	// the HTTP rule was created before the failure and must stay owned by the rule set
+	if d.Id() == "" {
+		t.Fatal("expected the rule set to be saved after a partial failure")
+	}
+	if got := d.Get("rule_ids").([]interface{}); len(got) != 1 || got[0] != "rule-1" {
+		t.Fatalf("expected the created rule to be owned, got %v", got)
+	}
+
+	// the next apply only creates the missing rule
+	if diags := resourceFirewallRuleSetUpdate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := fake.ports(); strings.Join(got, ",") != "80,443" {
+		t.Errorf("expected the HTTPS rule to be added to the HTTP rule, got %v", got)
+	}
+}
+
+func TestResourceFirewallRuleSetUpdatePartialFailure(t *testing.T) {
+	fake := &fakeFirewallRulesServer{}
+	config, server := newFakeFirewallRulesConfig(t, fake)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceFirewallRuleSet().Schema, map[string]interface{}{
+		"firewall_id": "fw-1",
+		"preset":      FirewallRulePresetSSHOnly,
+	})
+
+	if diags := resourceFirewallRuleSetCreate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// the HTTP rule is created, the HTTPS rule fails, so the ssh rule is never deleted
+	fake.mu.Lock()
+	fake.failPost = fake.posts + 2
+	fake.mu.Unlock()
+
+	d.Set("preset", FirewallRulePresetWeb)
+	if diags := resourceFirewallRuleSetUpdate(context.Background(), d, config); !diags.HasError() {
+		t.Fatal("expected the failed POST to be reported")
+	}
+
+	if got := d.Get("rule_ids").([]interface{}); len(got) != 2 || got[0] != "rule-2" || got[1] != "rule-1" {
+		t.Fatalf("expected the created and the remaining rule to be owned, got %v", got)
+	}
+}
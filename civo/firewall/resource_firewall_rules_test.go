@@ -0,0 +1,34 @@
+package firewall
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/civo/civogo"
+)
+
+func TestSortFirewallRules(t *testing.T) {
+	expected := []civogo.FirewallRule{
+		{ID: "1", Direction: "egress", Protocol: "tcp", StartPort: "1", Ports: "1-65535", Cidr: []string{"0.0.0.0/0"}},
+		{ID: "2", Direction: "ingress", Protocol: "tcp", StartPort: "22", Ports: "22", Cidr: []string{"10.0.0.0/8"}},
+		{ID: "3", Direction: "ingress", Protocol: "tcp", StartPort: "22", Ports: "22", Cidr: []string{"192.168.0.0/16", "172.16.0.0/12"}},
+		{ID: "4", Direction: "ingress", Protocol: "tcp", StartPort: "80", Ports: "80", Cidr: []string{"0.0.0.0/0"}},
+		{ID: "5", Direction: "ingress", Protocol: "tcp", StartPort: "443", Ports: "443", Cidr: []string{"0.0.0.0/0"}},
+		{ID: "6", Direction: "ingress", Protocol: "udp", StartPort: "53", Ports: "53", Cidr: []string{"0.0.0.0/0"}},
+		// the same rule twice, only the ID tells them apart
+		{ID: "7", Direction: "ingress", Protocol: "udp", StartPort: "123", Ports: "123", Cidr: []string{"0.0.0.0/0"}, Label: "ntp"},
+		{ID: "8", Direction: "ingress", Protocol: "udp", StartPort: "123", Ports: "123", Cidr: []string{"0.0.0.0/0"}, Label: "ntp again"},
+	}
+
+	for i := 0; i < 10; i++ {
+		rules := append([]civogo.FirewallRule(nil), expected...)
+		rand.Shuffle(len(rules), func(a, b int) { rules[a], rules[b] = rules[b], rules[a] })
+
+		SortFirewallRules(rules)
+
+		if !reflect.DeepEqual(rules, expected) {
+			t.Fatalf("expected the rules in a stable order, got %+v", rules)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -132,7 +133,7 @@ func CivoFirewallResourceExists(n string, firewall *civogo.Firewall) resource.Te
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 		resp, err := client.FindFirewall(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Firewall not found: (%s) %s", rs.Primary.ID, err)
@@ -156,7 +157,7 @@ func CivoFirewallUpdated(firewall *civogo.Firewall, name string) resource.TestCh
 }
 
 func CivoFirewallDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_firewall" {
@@ -0,0 +1,124 @@
+package instances
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCustomizeDiffInstanceUsesResourceRegion(t *testing.T) {
+	var mu sync.Mutex
+	regions := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		regions[req.URL.Path] = req.URL.Query().Get("region")
+		mu.Unlock()
+
+		switch req.URL.Path {
+		case "/v2/sizes":
+			rw.Write([]byte(`[{"name": "g3.large", "type": "instance", "selectable": true, "cpu_cores": 4, "ram_mb": 8192, "disk_gb": 100}]`))
+		case "/v2/quota":
+			rw.Write([]byte(`{"instance_count_limit": 10, "cpu_core_limit": 20, "ram_mb_limit": 65536, "disk_gb_limit": 1000}`))
+		case "/v2/regions":
+			rw.Write([]byte(`[{"code": "NYC1", "out_of_capacity": false}]`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+	// another resource left the shared client pointing to LON1
+	client.Region = "LON1"
+	config := &utils.CombinedConfig{Client: client, Region: "FRA1"}
+
+	_, err = ResourceInstance().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"region":      "NYC1",
+		"size":        "g3.large",
+		"disk_image":  "b82168fe-66f6-4b4d-a4d2-2d8dbd4ad3e5",
+		"firewall_id": "d4d48f41-5a7e-4f9c-a1b8-6c1b4e3f4c2a",
+	}), config)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, path := range []string{"/v2/sizes", "/v2/quota", "/v2/regions"} {
+		if got := regions[path]; got != "NYC1" {
+			t.Errorf("expected %s to be requested in the region of the instance, got %q", path, got)
+		}
+	}
+}
+
+func TestCustomizeDiffInstanceFirewallRuleChanges(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v2/firewalls/new-firewall/rules":
+			rw.Write([]byte(`[{"id": "rule-2", "protocol": "tcp", "ports": "443", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "https"}]`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	state := &terraform.InstanceState{
+		ID: "12345",
+		Attributes: map[string]string{
+			"id":                                    "12345",
+			"hostname":                              "foo.example.com",
+			"size":                                  "g3.small",
+			"disk_image":                            "b82168fe-66f6-4b4d-a4d2-2d8dbd4ad3e5",
+			"firewall_id":                           "old-firewall",
+			"effective_firewall_rules.#":            "1",
+			"effective_firewall_rules.0.id":         "rule-1",
+			"effective_firewall_rules.0.protocol":   "tcp",
+			"effective_firewall_rules.0.port_range": "22",
+			"effective_firewall_rules.0.direction":  "ingress",
+			"effective_firewall_rules.0.action":     "allow",
+			"effective_firewall_rules.0.label":      "ssh",
+			"effective_firewall_rules.0.cidr.#":     "1",
+			"effective_firewall_rules.0.cidr.0":     "0.0.0.0/0",
+		},
+	}
+
+	diff, err := ResourceInstance().Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"hostname":    "foo.example.com",
+		"size":        "g3.small",
+		"disk_image":  "b82168fe-66f6-4b4d-a4d2-2d8dbd4ad3e5",
+		"firewall_id": "new-firewall",
+	}), &utils.CombinedConfig{Client: client})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// the summary of the rule changes shows in the plan
+	expected := map[string]string{
+		"firewall_rule_changes.#": "2",
+		"firewall_rule_changes.0": "added allow ingress tcp 443 from 0.0.0.0/0 (https)",
+		"firewall_rule_changes.1": "removed allow ingress tcp 22 from 0.0.0.0/0 (ssh)",
+	}
+	for key, value := range expected {
+		attr, ok := diff.Attributes[key]
+		if !ok {
+			t.Errorf("expected %s in the plan", key)
+			continue
+		}
+		if attr.New != value {
+			t.Errorf("expected %s to be %q, got %q", key, value, attr.New)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -122,6 +123,12 @@ func DataSourceInstance() *schema.Resource {
 				Computed:    true,
 				Description: "The public IP",
 			},
+			"public_ipv6": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public IPv6 address, empty if the instance has no IPv6 address",
+			},
+			"volumes": instanceVolumesSchema(),
 			"pseudo_ip": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -135,19 +142,20 @@ func DataSourceInstance() *schema.Resource {
 			"created_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The date of creation of the instance",
+				Description: "The date of creation of the instance, in RFC3339 format",
 			},
 		},
 	}
 }
 
 func dataSourceInstanceRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	config := m.(*utils.CombinedConfig)
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	region, err := utils.ResolveRegion(d.Get("region").(string), config)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	apiClient := utils.ClientForRegion(config, region)
 
 	var foundImage *civogo.Instance
 
@@ -176,21 +184,32 @@ func dataSourceInstanceRead(_ context.Context, d *schema.ResourceData, m interfa
 	d.Set("hostname", foundImage.Hostname)
 	d.Set("reverse_dns", foundImage.ReverseDNS)
 	d.Set("size", foundImage.Size)
-	d.Set("cpu_cores", foundImage.CPUCores)
-	d.Set("ram_mb", foundImage.RAMMegabytes)
-	d.Set("disk_gb", foundImage.DiskGigabytes)
+	cpuCores, ramMegabytes, diskGigabytes := instanceSpecs(apiClient, foundImage)
+	d.Set("cpu_cores", cpuCores)
+	d.Set("ram_mb", ramMegabytes)
+	d.Set("disk_gb", diskGigabytes)
 	d.Set("initial_user", foundImage.InitialUser)
 	d.Set("initial_password", foundImage.InitialPassword)
 	d.Set("sshkey_id", foundImage.SSHKey)
 	d.Set("tags", foundImage.Tags)
 	d.Set("private_ip", foundImage.PrivateIP)
 	d.Set("public_ip", foundImage.PublicIP)
+	d.Set("public_ipv6", foundImage.IPv6)
+	d.Set("firewall_id", foundImage.FirewallID)
 	d.Set("pseudo_ip", foundImage.PseudoIP)
 	d.Set("status", foundImage.Status)
-	d.Set("region", apiClient.Region)
+	d.Set("region", utils.RegionFromResponse(foundImage.Region, apiClient))
 	d.Set("script", foundImage.Script)
-	d.Set("created_at", foundImage.CreatedAt.UTC().String())
+	d.Set("created_at", utils.FormatTime(foundImage.CreatedAt))
 	d.Set("notes", foundImage.Notes)
 
+	volumes, err := attachedVolumes(apiClient, foundImage.ID)
+	if err != nil {
+		return diag.Errorf("[ERR] failed to list the volumes of the instance: %s", err)
+	}
+	if err := d.Set("volumes", volumes); err != nil {
+		return diag.Errorf("[ERR] error setting the volumes of the instance: %s", err)
+	}
+
 	return nil
 }
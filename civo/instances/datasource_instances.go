@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,6 +16,7 @@ func DataSourceInstances() *schema.Resource {
 		Description: strings.Join([]string{
 			"Get information on instances for use in other resources, with the ability to filter and sort the results. If no filters are specified, all instances will be returned.",
 			"Note: You can use the `civo_instance` data source to obtain metadata about a single instance if you already know the id, unique hostname, or unique tag to retrieve.",
+			"Filters and sorting are applied by the provider once the instances are retrieved, as the Civo API has no server-side filters. When only `limit` is set it is sent to the API, so fewer instances are retrieved.",
 		}, "\n\n"),
 		RecordSchema: instancesSchema(),
 		ExtraQuerySchema: map[string]*schema.Schema{
@@ -34,49 +36,57 @@ func DataSourceInstances() *schema.Resource {
 }
 
 func getDataSourceInstances(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
+	config := m.(*utils.CombinedConfig)
 
-	// overwrite the region if is define in the datasource
 	region, ok := extra["region"].(string)
 	if !ok {
 		return nil, fmt.Errorf("unable to find `region` key from query data")
 	}
 
-	if region != "" {
-		apiClient.Region = region
+	region, err := utils.ResolveRegion(region, config)
+	if err != nil {
+		return nil, err
+	}
+	apiClient := utils.ClientForRegion(config, region)
+
+	// ask the API for fewer instances if the data source only needs some of them
+	perPage := 200
+	if limit, ok := extra[datalist.LimitKey].(int); ok && limit < perPage {
+		perPage = limit
 	}
 
 	var instance []interface{}
-	partialInstances, err := apiClient.ListInstances(1, 200)
+	partialInstances, err := apiClient.ListInstances(1, perPage)
 	if err != nil {
 		return nil, fmt.Errorf("[ERR] error retrieving instances: %s", err)
 	}
 
 	for _, partialInstance := range partialInstances.Items {
+		partialInstance.Region = utils.RegionFromResponse(partialInstance.Region, apiClient)
 		instance = append(instance, partialInstance)
 	}
 
 	return instance, nil
 }
 
-func flattenDataSourceInstances(instance, _ interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
-
-	region, ok := extra["region"].(string)
-	if !ok {
-		return nil, fmt.Errorf("unable to find `region` key from query data")
-	}
-
+func flattenDataSourceInstances(instance, m interface{}, _ map[string]interface{}) (map[string]interface{}, error) {
 	i := instance.(civogo.Instance)
 
+	// the region was already resolved when the records were retrieved, the specs are looked up
+	// in the sizes of that region rather than the region of the shared client
+	region := i.Region
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), region)
+
 	flattenedInstance := map[string]interface{}{}
 	flattenedInstance["id"] = i.ID
 	flattenedInstance["hostname"] = i.Hostname
 	flattenedInstance["region"] = region
 	flattenedInstance["reverse_dns"] = i.ReverseDNS
 	flattenedInstance["size"] = i.Size
-	flattenedInstance["cpu_cores"] = i.CPUCores
-	flattenedInstance["ram_mb"] = i.RAMMegabytes
-	flattenedInstance["disk_gb"] = i.DiskGigabytes
+	cpuCores, ramMegabytes, diskGigabytes := instanceSpecs(apiClient, &i)
+	flattenedInstance["cpu_cores"] = cpuCores
+	flattenedInstance["ram_mb"] = ramMegabytes
+	flattenedInstance["disk_gb"] = diskGigabytes
 	flattenedInstance["network_id"] = i.NetworkID
 	flattenedInstance["template"] = i.TemplateID
 	flattenedInstance["initial_user"] = i.InitialUser
@@ -90,7 +100,7 @@ func flattenDataSourceInstances(instance, _ interface{}, extra map[string]interf
 	flattenedInstance["public_ip"] = i.PrivateIP
 	flattenedInstance["pseudo_ip"] = i.PseudoIP
 	flattenedInstance["status"] = i.Status
-	flattenedInstance["created_at"] = i.CreatedAt.UTC().String()
+	flattenedInstance["created_at"] = utils.FormatTime(i.CreatedAt)
 
 	return flattenedInstance, nil
 }
@@ -184,7 +194,7 @@ func instancesSchema() map[string]*schema.Schema {
 		},
 		"created_at": {
 			Type:        schema.TypeString,
-			Description: "Creation date of the instance",
+			Description: "Creation date of the instance, in RFC3339 format",
 		},
 	}
 }
@@ -0,0 +1,125 @@
+package instances
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+)
+
+// fakeInstancesServer lists the instances, honouring per_page like the API does
+func fakeInstancesServer(t *testing.T, hostnames []string) (*utils.CombinedConfig, *httptest.Server, *int) {
+	t.Helper()
+
+	requestedPerPage := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v2/instances" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		perPage, _ := strconv.Atoi(req.URL.Query().Get("per_page"))
+		requestedPerPage = perPage
+
+		items := []string{}
+		for i, hostname := range hostnames {
+			if perPage > 0 && i >= perPage {
+				break
+			}
+			items = append(items, fmt.Sprintf(`{"id": "id-%d", "hostname": "%s", "status": "ACTIVE"}`, i, hostname))
+		}
+		rw.Write([]byte(fmt.Sprintf(`{"page": 1, "per_page": %d, "pages": 1, "items": [%s]}`, perPage, strings.Join(items, ","))))
+	}))
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	return &utils.CombinedConfig{Client: client}, server, &requestedPerPage
+}
+
+func TestGetDataSourceInstancesLimit(t *testing.T) {
+	hostnames := []string{"web-1", "web-2", "web-3"}
+
+	config, server, requestedPerPage := fakeInstancesServer(t, hostnames)
+	defer server.Close()
+
+	// without a limit, every instance is retrieved and the limit is applied client-side
+	all, err := getDataSourceInstances(config, map[string]interface{}{"region": "LON1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(all) != len(hostnames) {
+		t.Fatalf("expected all the instances, got %d", len(all))
+	}
+
+	// with a limit and nothing to filter or sort, the API is asked for fewer instances
+	limited, err := getDataSourceInstances(config, map[string]interface{}{"region": "LON1", datalist.LimitKey: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *requestedPerPage != 2 {
+		t.Errorf("expected the limit to be sent to the API, got per_page=%d", *requestedPerPage)
+	}
+
+	// both ways return the same instances
+	if len(limited) != 2 {
+		t.Fatalf("expected 2 instances, got %d", len(limited))
+	}
+	for i := range limited {
+		if limited[i].(civogo.Instance).ID != all[i].(civogo.Instance).ID {
+			t.Errorf("expected the same instances server-side and client-side, got %s and %s", limited[i].(civogo.Instance).ID, all[i].(civogo.Instance).ID)
+		}
+	}
+}
+
+func TestFlattenDataSourceInstancesRegion(t *testing.T) {
+	sizesRegion := ""
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/instances":
+			rw.Write([]byte(`{"page": 1, "per_page": 200, "pages": 1, "items": [{"id": "id-0", "hostname": "web", "size": "g3.small", "status": "ACTIVE"}]}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/sizes":
+			sizesRegion = req.URL.Query().Get("region")
+			rw.Write([]byte(`[{"name": "g3.small", "cpu_cores": 1, "ram_mb": 2048, "disk_gb": 25}]`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+	config := &utils.CombinedConfig{Client: client, Region: "LON1"}
+
+	// the API omits the region, the one of the data source is used instead of the shared client
+	records, err := getDataSourceInstances(config, map[string]interface{}{"region": "NYC1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	flattened, err := flattenDataSourceInstances(records[0], config, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if flattened["region"] != "NYC1" {
+		t.Errorf("expected the region to be NYC1, got %v", flattened["region"])
+	}
+	if sizesRegion != "NYC1" {
+		t.Errorf("expected the sizes to be listed in NYC1, got %q", sizesRegion)
+	}
+	if flattened["cpu_cores"] != 1 {
+		t.Errorf("expected the CPU cores of the size, got %v", flattened["cpu_cores"])
+	}
+}
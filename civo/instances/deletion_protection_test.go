@@ -0,0 +1,83 @@
+package instances
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fakeDeleteServer serves the instance 12345 until it is deleted
+func fakeDeleteServer(t *testing.T) (*utils.CombinedConfig, *httptest.Server, *bool) {
+	t.Helper()
+
+	var mu sync.Mutex
+	deleted := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case req.Method == http.MethodDelete && req.URL.Path == "/v2/instances/12345":
+			deleted = true
+			rw.Write([]byte(`{"result": "success"}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/instances/12345" && !deleted:
+			rw.Write([]byte(`{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE"}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/instances/12345":
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"code": "database_instance_find", "reason": "The instance could not be found"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	return &utils.CombinedConfig{Client: client}, server, &deleted
+}
+
+func TestResourceInstanceDeleteProtected(t *testing.T) {
+	config, server, deleted := fakeDeleteServer(t)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{
+		"deletion_protection": true,
+		"graceful_shutdown":   false,
+	})
+	d.SetId("12345")
+
+	if diags := resourceInstanceDelete(context.Background(), d, config); !diags.HasError() {
+		t.Fatalf("expected the delete of a protected instance to fail")
+	}
+
+	if *deleted {
+		t.Errorf("expected the protected instance not to be deleted")
+	}
+}
+
+func TestResourceInstanceDeleteUnprotected(t *testing.T) {
+	config, server, deleted := fakeDeleteServer(t)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{
+		"graceful_shutdown": false,
+	})
+	d.SetId("12345")
+
+	if diags := resourceInstanceDelete(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !*deleted {
+		t.Errorf("expected the instance to be deleted")
+	}
+}
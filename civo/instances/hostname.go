@@ -0,0 +1,44 @@
+package instances
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serverHostnameSuffix matches the numeric suffix Civo appends to a hostname
+// when another instance in the account is already using it (e.g. web -> web-1)
+var serverHostnameSuffix = regexp.MustCompile(`^-[0-9]+$`)
+
+// suppressServerHostnameSuffix avoids a diff when the hostname in the state is the hostname
+// Terraform sent to the API with a suffix applied by Civo, this is common when using `count`
+// with a fixed hostname. The hostname is kept in the state as the API returns it, so when the
+// hostname was sent without a domain the domain Civo may store it with (e.g. web.example.com
+// for web) is ignored as well. Only the hostname recorded in requested_hostname is compared,
+// so renaming web-1 or web.example.com to web still shows a diff
+func suppressServerHostnameSuffix(_, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	if new == "" || d == nil || new != d.Get("requested_hostname").(string) {
+		return false
+	}
+
+	suffix, ok := strings.CutPrefix(old, new)
+	if !ok {
+		return false
+	}
+
+	if !strings.Contains(new, ".") {
+		if before, _, found := strings.Cut(suffix, "."); found {
+			if before == "" {
+				return true
+			}
+			suffix = before
+		}
+	}
+
+	return serverHostnameSuffix.MatchString(suffix)
+}
@@ -0,0 +1,45 @@
+package instances
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSuppressServerHostnameSuffix(t *testing.T) {
+	cases := []struct {
+		name      string
+		requested string
+		old       string
+		new       string
+		suppress  bool
+	}{
+		{name: "same hostname", requested: "web", old: "web", new: "web", suppress: true},
+		{name: "server applied suffix", requested: "web", old: "web-1", new: "web", suppress: true},
+		{name: "server applied multi digit suffix", requested: "web", old: "web-12", new: "web", suppress: true},
+		{name: "hostname changed", requested: "web", old: "web", new: "api", suppress: false},
+		{name: "non numeric suffix", requested: "web", old: "web-prod", new: "web", suppress: false},
+		{name: "suffix without separator", requested: "web", old: "web1", new: "web", suppress: false},
+		{name: "new hostname is longer", requested: "web", old: "web", new: "web-1", suppress: false},
+		{name: "hostname not set", requested: "web", old: "web-1", new: "", suppress: false},
+		{name: "domain suffix", requested: "web", old: "web.example.com", new: "web", suppress: true},
+		{name: "domain and server applied suffix", requested: "web", old: "web-1.example.com", new: "web", suppress: true},
+		{name: "domain suffix on another hostname", requested: "web", old: "api.example.com", new: "web", suppress: false},
+		{name: "domain changed", requested: "web.example.com", old: "web.example.com", new: "web.example.org", suppress: false},
+		{name: "domain added", requested: "web", old: "web", new: "web.example.com", suppress: false},
+		{name: "renamed from a numbered hostname", requested: "web-1", old: "web-1", new: "web", suppress: false},
+		{name: "renamed from a full hostname", requested: "web.example.com", old: "web.example.com", new: "web", suppress: false},
+		{name: "imported with a full hostname", requested: "web.example.com", old: "web.example.com", new: "web", suppress: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{})
+			d.Set("requested_hostname", tc.requested)
+
+			if got := suppressServerHostnameSuffix("hostname", tc.old, tc.new, d); got != tc.suppress {
+				t.Fatalf("expected %t for old %q and new %q with %q requested, got %t", tc.suppress, tc.old, tc.new, tc.requested, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package instances
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/civo/firewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// firewallReadyTimeout is how long we wait for a firewall created in the same apply to be usable
+const firewallReadyTimeout = 2 * time.Minute
+
+// setInstanceFirewall moves the instance to the firewall, a firewall that was just created can
+// take a moment to be usable so the firewall not found errors are retried until the timeout. The
+// first one is checked against the firewalls of the region, a firewall that doesn't exist fails at once
+func setInstanceFirewall(ctx context.Context, apiClient *civogo.Client, instanceID, firewallID string, timeout time.Duration) error {
+	checked := false
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		_, err := apiClient.SetInstanceFirewall(instanceID, firewallID)
+		if err != nil {
+			if errors.Is(err, civogo.DatabaseFirewallNotFoundError) {
+				if !checked {
+					checked = true
+					if firewallMissing(apiClient, firewallID) {
+						return retry.NonRetryableError(fmt.Errorf("the firewall %s doesn't exist: %s", firewallID, err))
+					}
+				}
+				log.Printf("[INFO] the firewall %s is not ready yet, retrying", firewallID)
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// firewallMissing reports whether the firewall is known not to exist, when the firewalls can't be
+// listed it may still exist
+func firewallMissing(apiClient *civogo.Client, firewallID string) bool {
+	firewall, err := apiClient.FindFirewall(firewallID)
+	if err != nil {
+		// several partial matches also mean that no firewall has exactly this ID
+		return errors.Is(err, civogo.ZeroMatchesError) || errors.Is(err, civogo.MultipleMatchesError)
+	}
+	return firewall.ID != firewallID
+}
+
+// instanceFirewallID returns the firewall a new instance is created with, the firewall_id of the
+// instance takes precedence over the firewall mapped to its tags in the provider, which itself
+// takes precedence over the default firewall of the provider
+func instanceFirewallID(d *schema.ResourceData, tagFirewalls map[string]string, defaultFirewallID string) (string, error) {
+	if attr, ok := d.GetOk("firewall_id"); ok {
+		return attr.(string), nil
+	}
+
+	firewallID, matchedTag := "", ""
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		tagFirewallID, ok := tagFirewalls[tag.(string)]
+		if !ok {
+			continue
+		}
+		if firewallID != "" && firewallID != tagFirewallID {
+			return "", fmt.Errorf("the tags %s and %s of the instance map to different firewalls (%s and %s) in `tag_firewall_map`, please set `firewall_id` in the instance", matchedTag, tag.(string), firewallID, tagFirewallID)
+		}
+		firewallID, matchedTag = tagFirewallID, tag.(string)
+	}
+	if firewallID != "" {
+		log.Printf("[INFO] using the firewall %s mapped to the tag %s in the provider", firewallID, matchedTag)
+		return firewallID, nil
+	}
+
+	if defaultFirewallID != "" {
+		log.Printf("[INFO] using the default firewall %s of the provider", defaultFirewallID)
+		return defaultFirewallID, nil
+	}
+
+	return "", errors.New("no firewall set for the instance, please set `firewall_id` in the instance or `default_firewall_id` in the provider")
+}
+
+// effectiveFirewallRules returns the rules of the firewall applied to the instance, flattened for the
+// effective_firewall_rules attribute, an instance without a firewall has no rules
+func effectiveFirewallRules(apiClient *civogo.Client, firewallID string) ([]interface{}, error) {
+	if firewallID == "" {
+		return []interface{}{}, nil
+	}
+
+	rules, err := apiClient.ListFirewallRules(firewallID)
+	if err != nil {
+		if errors.Is(err, civogo.DatabaseFirewallNotFoundError) {
+			log.Printf("[WARN] the firewall %s of the instance no longer exists", firewallID)
+			return []interface{}{}, nil
+		}
+		return nil, err
+	}
+
+	// the API doesn't return the rules in a stable order
+	firewall.SortFirewallRules(rules)
+
+	flattenedRules := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		flattenedRules[i] = map[string]interface{}{
+			"id":         rule.ID,
+			"direction":  rule.Direction,
+			"label":      rule.Label,
+			"protocol":   rule.Protocol,
+			"port_range": rule.Ports,
+			"action":     rule.Action,
+			"cidr":       rule.Cidr,
+		}
+	}
+
+	return flattenedRules, nil
+}
+
+// customizeDiffInstanceFirewall plans the rules of the new firewall of the instance and a summary of
+// the rules gained and lost in firewall_rule_changes, so both show in the plan. It's only advisory,
+// they are left to be known after apply when the rules can't be read
+func customizeDiffInstanceFirewall(d *schema.ResourceDiff, apiClient *civogo.Client) error {
+	if !d.HasChange("firewall_id") {
+		return nil
+	}
+
+	if !d.NewValueKnown("firewall_id") {
+		return setFirewallRulesComputed(d)
+	}
+
+	newRules, err := effectiveFirewallRules(apiClient, d.Get("firewall_id").(string))
+	if err != nil {
+		log.Printf("[WARN] unable to read the rules of the firewall %s: %s", d.Get("firewall_id").(string), err)
+		return setFirewallRulesComputed(d)
+	}
+
+	oldRules, _ := d.GetChange("effective_firewall_rules")
+	if err := d.SetNew("firewall_rule_changes", firewallRuleChanges(oldRules.([]interface{}), newRules)); err != nil {
+		return err
+	}
+
+	return d.SetNew("effective_firewall_rules", newRules)
+}
+
+// setFirewallRulesComputed leaves the rules of the instance and their changes to be known after apply
+func setFirewallRulesComputed(d *schema.ResourceDiff) error {
+	if err := d.SetNewComputed("firewall_rule_changes"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("effective_firewall_rules")
+}
+
+// firewallRuleChanges summarises the rules added and removed between two lists of effective firewall
+// rules, the rules are compared on what they do since the IDs differ from a firewall to another
+func firewallRuleChanges(oldRules, newRules []interface{}) []string {
+	oldSet := map[string]bool{}
+	for _, rule := range oldRules {
+		oldSet[describeFirewallRule(rule.(map[string]interface{}))] = true
+	}
+
+	newSet := map[string]bool{}
+	for _, rule := range newRules {
+		newSet[describeFirewallRule(rule.(map[string]interface{}))] = true
+	}
+
+	changes := []string{}
+	for rule := range newSet {
+		if !oldSet[rule] {
+			changes = append(changes, "added "+rule)
+		}
+	}
+	for rule := range oldSet {
+		if !newSet[rule] {
+			changes = append(changes, "removed "+rule)
+		}
+	}
+	sort.Strings(changes)
+
+	return changes
+}
+
+// describeFirewallRule returns a human readable description of a flattened rule,
+// e.g. "allow ingress tcp 443 from 0.0.0.0/0 (https)"
+func describeFirewallRule(rule map[string]interface{}) string {
+	cidrs := []string{}
+	switch v := rule["cidr"].(type) {
+	case []string:
+		cidrs = append(cidrs, v...)
+	case []interface{}:
+		for _, cidr := range v {
+			cidrs = append(cidrs, cidr.(string))
+		}
+	}
+
+	peer := "from"
+	if rule["direction"] == "egress" {
+		peer = "to"
+	}
+
+	description := fmt.Sprintf("%s %s %s %s %s %s", rule["action"], rule["direction"], rule["protocol"], rule["port_range"], peer, strings.Join(cidrs, ", "))
+	if label, ok := rule["label"].(string); ok && label != "" {
+		description += fmt.Sprintf(" (%s)", label)
+	}
+
+	return description
+}
@@ -0,0 +1,207 @@
+package instances
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fakeFirewallServer answers firewall not found until the firewall has been asked for notReadyCalls
+// times, firewallsJSON is the list of firewalls of the region
+func fakeFirewallServer(t *testing.T, notReadyCalls int, code, firewallsJSON string) (*civogo.Client, *httptest.Server, *int) {
+	t.Helper()
+
+	var mu sync.Mutex
+	calls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if req.Method == http.MethodGet && req.URL.Path == "/v2/firewalls" {
+			rw.Write([]byte(firewallsJSON))
+			return
+		}
+		if req.Method != http.MethodPut || req.URL.Path != "/v2/instances/12345/firewall" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		calls++
+		if calls <= notReadyCalls {
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"code": "` + code + `", "reason": "The firewall could not be found"}`))
+			return
+		}
+		rw.Write([]byte(`{"result": "success"}`))
+	}))
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	return client, server, &calls
+}
+
+func TestSetInstanceFirewallRetriesUntilReady(t *testing.T) {
+	client, server, calls := fakeFirewallServer(t, 1, "database_firewall_not_found", `[{"id": "fw-1", "name": "web"}]`)
+	defer server.Close()
+
+	if err := setInstanceFirewall(context.Background(), client, "12345", "fw-1", 30*time.Second); err != nil {
+		t.Fatalf("expected the firewall to be set once ready, got %s", err)
+	}
+
+	if *calls != 2 {
+		t.Errorf("expected 2 calls, got %d", *calls)
+	}
+}
+
+func TestSetInstanceFirewallDoesNotRetryOtherErrors(t *testing.T) {
+	client, server, calls := fakeFirewallServer(t, 1, "database_instance_not_found", `[]`)
+	defer server.Close()
+
+	if err := setInstanceFirewall(context.Background(), client, "12345", "fw-1", 30*time.Second); err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	if *calls != 1 {
+		t.Errorf("expected a single call, got %d", *calls)
+	}
+}
+
+func TestSetInstanceFirewallDoesNotRetryMissingFirewall(t *testing.T) {
+	// only fw-10 exists, fw-1 partially matching it must not count as existing
+	client, server, calls := fakeFirewallServer(t, 10, "database_firewall_not_found", `[{"id": "fw-10", "name": "web"}]`)
+	defer server.Close()
+
+	err := setInstanceFirewall(context.Background(), client, "12345", "fw-1", 30*time.Second)
+	if err == nil || !strings.Contains(err.Error(), "the firewall fw-1 doesn't exist") {
+		t.Fatalf("expected the missing firewall to be reported, got %v", err)
+	}
+
+	if *calls != 1 {
+		t.Errorf("expected a single call, got %d", *calls)
+	}
+}
+
+func TestInstanceFirewallID(t *testing.T) {
+	cases := []struct {
+		name              string
+		raw               map[string]interface{}
+		tagFirewalls      map[string]string
+		defaultFirewallID string
+		expected          string
+		expectErr         bool
+	}{
+		{
+			name:              "inherited from the provider",
+			raw:               map[string]interface{}{},
+			defaultFirewallID: "default-fw",
+			expected:          "default-fw",
+		},
+		{
+			name:              "overridden by the instance",
+			raw:               map[string]interface{}{"firewall_id": "instance-fw"},
+			defaultFirewallID: "default-fw",
+			expected:          "instance-fw",
+		},
+		{
+			name:     "set only on the instance",
+			raw:      map[string]interface{}{"firewall_id": "instance-fw"},
+			expected: "instance-fw",
+		},
+		{
+			name:              "mapped to a tag",
+			raw:               map[string]interface{}{"tags": []interface{}{"web", "production"}},
+			tagFirewalls:      map[string]string{"web": "web-fw", "db": "db-fw"},
+			defaultFirewallID: "default-fw",
+			expected:          "web-fw",
+		},
+		{
+			name:         "tags mapped to the same firewall",
+			raw:          map[string]interface{}{"tags": []interface{}{"web", "frontend"}},
+			tagFirewalls: map[string]string{"web": "web-fw", "frontend": "web-fw"},
+			expected:     "web-fw",
+		},
+		{
+			name:         "tags mapped to different firewalls",
+			raw:          map[string]interface{}{"tags": []interface{}{"web", "db"}},
+			tagFirewalls: map[string]string{"web": "web-fw", "db": "db-fw"},
+			expectErr:    true,
+		},
+		{
+			name:              "no tag mapped",
+			raw:               map[string]interface{}{"tags": []interface{}{"cache"}},
+			tagFirewalls:      map[string]string{"web": "web-fw"},
+			defaultFirewallID: "default-fw",
+			expected:          "default-fw",
+		},
+		{
+			name:         "tag mapping overridden by the instance",
+			raw:          map[string]interface{}{"firewall_id": "instance-fw", "tags": []interface{}{"web"}},
+			tagFirewalls: map[string]string{"web": "web-fw"},
+			expected:     "instance-fw",
+		},
+		{
+			name:      "not set anywhere",
+			raw:       map[string]interface{}{},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, tc.raw)
+
+			firewallID, err := instanceFirewallID(d, tc.tagFirewalls, tc.defaultFirewallID)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error, got the firewall %q", firewallID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if firewallID != tc.expected {
+				t.Errorf("expected the firewall %q, got %q", tc.expected, firewallID)
+			}
+		})
+	}
+}
+
+func TestFirewallRuleChanges(t *testing.T) {
+	// the old rules come from the state, the new ones from the API
+	oldRules := []interface{}{
+		map[string]interface{}{"id": "old-1", "direction": "ingress", "label": "ssh", "protocol": "tcp", "port_range": "22", "action": "allow", "cidr": []interface{}{"0.0.0.0/0"}},
+		map[string]interface{}{"id": "old-2", "direction": "egress", "label": "", "protocol": "tcp", "port_range": "1-65535", "action": "allow", "cidr": []interface{}{"0.0.0.0/0"}},
+	}
+	newRules := []interface{}{
+		map[string]interface{}{"id": "new-1", "direction": "ingress", "label": "ssh", "protocol": "tcp", "port_range": "22", "action": "allow", "cidr": []string{"10.0.0.0/8"}},
+		map[string]interface{}{"id": "new-2", "direction": "ingress", "label": "https", "protocol": "tcp", "port_range": "443", "action": "allow", "cidr": []string{"0.0.0.0/0"}},
+		map[string]interface{}{"id": "new-3", "direction": "egress", "label": "", "protocol": "tcp", "port_range": "1-65535", "action": "allow", "cidr": []string{"0.0.0.0/0"}},
+	}
+
+	expected := []string{
+		"added allow ingress tcp 22 from 10.0.0.0/8 (ssh)",
+		"added allow ingress tcp 443 from 0.0.0.0/0 (https)",
+		"removed allow ingress tcp 22 from 0.0.0.0/0 (ssh)",
+	}
+
+	if got := firewallRuleChanges(oldRules, newRules); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if got := firewallRuleChanges(newRules, newRules); len(got) != 0 {
+		t.Errorf("expected no changes between the same rules, got %v", got)
+	}
+}
@@ -0,0 +1,21 @@
+package instances
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// instanceSSHKeyID returns the SSH key a new instance is created with, the sshkey_id of the
+// instance takes precedence over the default SSH key of the provider, an empty ID means no key
+func instanceSSHKeyID(d *schema.ResourceData, defaultSSHKeyID string) string {
+	if attr, ok := d.GetOk("sshkey_id"); ok {
+		return attr.(string)
+	}
+
+	if defaultSSHKeyID != "" {
+		log.Printf("[INFO] using the default SSH key %s of the provider", defaultSSHKeyID)
+	}
+
+	return defaultSSHKeyID
+}
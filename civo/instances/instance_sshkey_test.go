@@ -0,0 +1,44 @@
+package instances
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestInstanceSSHKeyID(t *testing.T) {
+	cases := []struct {
+		name            string
+		raw             map[string]interface{}
+		defaultSSHKeyID string
+		expected        string
+	}{
+		{
+			name:            "inherited from the provider",
+			raw:             map[string]interface{}{},
+			defaultSSHKeyID: "9a8e6a4c-4e7b-4a77-9fb5-4d2c8b0a5b10",
+			expected:        "9a8e6a4c-4e7b-4a77-9fb5-4d2c8b0a5b10",
+		},
+		{
+			name:            "overridden by the instance",
+			raw:             map[string]interface{}{"sshkey_id": "1f2b7c44-8d3e-4c1a-b6e2-0c5d9a7e3f21"},
+			defaultSSHKeyID: "9a8e6a4c-4e7b-4a77-9fb5-4d2c8b0a5b10",
+			expected:        "1f2b7c44-8d3e-4c1a-b6e2-0c5d9a7e3f21",
+		},
+		{
+			name:     "no key at all",
+			raw:      map[string]interface{}{},
+			expected: "",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, c.raw)
+
+			if got := instanceSSHKeyID(d, c.defaultSSHKeyID); got != c.expected {
+				t.Errorf("expected the SSH key %q, got %q", c.expected, got)
+			}
+		})
+	}
+}
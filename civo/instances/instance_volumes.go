@@ -0,0 +1,78 @@
+package instances
+
+import (
+	"sort"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// instanceVolumesSchema is the computed list of the volumes attached to an instance,
+// shared by the instance resource and data source
+func instanceVolumesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The volumes attached to the instance, ordered by device path",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the volume",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the volume",
+				},
+				"size_gb": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The size of the volume in GB",
+				},
+				"device_path": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The device path of the volume on the instance",
+				},
+			},
+		},
+	}
+}
+
+// attachedVolumes returns the volumes attached to the instance flattened for the volumes attribute,
+// the API has no per instance listing so all the volumes of the region are filtered
+func attachedVolumes(apiClient *civogo.Client, instanceID string) ([]interface{}, error) {
+	volumes, err := apiClient.ListVolumes()
+	if err != nil {
+		return nil, err
+	}
+
+	attached := []civogo.Volume{}
+	for _, volume := range volumes {
+		if volume.InstanceID == instanceID {
+			attached = append(attached, volume)
+		}
+	}
+
+	// keep the list stable between refreshes, the ID breaks the tie of volumes still being attached
+	sort.Slice(attached, func(i, j int) bool {
+		if attached[i].MountPoint != attached[j].MountPoint {
+			return attached[i].MountPoint < attached[j].MountPoint
+		}
+		return attached[i].ID < attached[j].ID
+	})
+
+	flattenedVolumes := make([]interface{}, len(attached))
+	for i, volume := range attached {
+		flattenedVolumes[i] = map[string]interface{}{
+			"id":          volume.ID,
+			"name":        volume.Name,
+			"size_gb":     volume.SizeGigabytes,
+			"device_path": volume.MountPoint,
+		}
+	}
+
+	return flattenedVolumes, nil
+}
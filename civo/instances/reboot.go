@@ -0,0 +1,96 @@
+package instances
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// rebootOnChangeAttributes are the attributes which can be listed in reboot_on_change, the
+// script only runs when the instance is built so it's left out
+var rebootOnChangeAttributes = []string{"size", "hostname", "notes", "reserved_ipv4", "firewall_id", "tags"}
+
+// changeDetector is the part of schema.ResourceData used to find out which attributes changed
+type changeDetector interface {
+	HasChange(key string) bool
+}
+
+// changedRebootAttributes returns the attributes from reboot_on_change which changed in this update
+func changedRebootAttributes(d changeDetector, attributes []interface{}) []string {
+	changed := []string{}
+	for _, attr := range attributes {
+		if d.HasChange(attr.(string)) {
+			changed = append(changed, attr.(string))
+		}
+	}
+	return changed
+}
+
+// rebootStartTimeout is how long we wait for the instance to leave ACTIVE once the reboot is
+// requested, a reboot quicker than the polling may never be seen
+const rebootStartTimeout = 30 * time.Second
+
+// rebootInstance soft reboots the instance and waits until it is active again or the timeout expires.
+// The instance is still ACTIVE for a moment after the reboot is requested, so we first wait for it to
+// leave ACTIVE, otherwise the wait could finish before the reboot even started
+func rebootInstance(ctx context.Context, config *utils.CombinedConfig, apiClient *civogo.Client, id string, timeout time.Duration) error {
+	log.Printf("[INFO] rebooting the instance %s", id)
+	if _, err := apiClient.SoftRebootInstance(id); err != nil {
+		return fmt.Errorf("failed to reboot the instance: %s", err)
+	}
+
+	startTimeout := rebootStartTimeout
+	if timeout < startTimeout {
+		startTimeout = timeout
+	}
+
+	rebootStartStateConf := &retry.StateChangeConf{
+		Pending: []string{"active"},
+		Target:  []string{"rebooting"},
+		Refresh: config.JitterPolls(ctx, config.LogTransitions(ctx, fmt.Sprintf("instance %s", id), func() (interface{}, string, error) {
+			resp, err := apiClient.GetInstance(id)
+			if err != nil {
+				return 0, "", err
+			}
+			if resp.Status == "ACTIVE" {
+				return resp, "active", nil
+			}
+			return resp, "rebooting", nil
+		})),
+		Timeout:      startTimeout,
+		PollInterval: time.Second,
+	}
+	if _, err := rebootStartStateConf.WaitForStateContext(ctx); err != nil {
+		var timeoutErr *retry.TimeoutError
+		if !errors.As(err, &timeoutErr) {
+			return fmt.Errorf("failed to follow the reboot of the instance: %s", err)
+		}
+		log.Printf("[WARN] the instance %s wasn't seen leaving ACTIVE after the reboot was requested", id)
+	}
+
+	rebootStateConf := &retry.StateChangeConf{
+		Pending: []string{"REBOOTING", "HARD_REBOOTING", "STOPPING", "SHUTOFF", "STARTING"},
+		Target:  []string{"ACTIVE"},
+		Refresh: config.JitterPolls(ctx, config.LogTransitions(ctx, fmt.Sprintf("instance %s", id), func() (interface{}, string, error) {
+			resp, err := apiClient.GetInstance(id)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Status, nil
+		})),
+		Timeout:      timeout,
+		MinTimeout:   config.PollDelay(3 * time.Second),
+		PollInterval: config.PollInterval(),
+	}
+	if _, err := rebootStateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("the instance didn't become active after the reboot: %s", err)
+	}
+
+	return nil
+}
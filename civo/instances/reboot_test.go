@@ -0,0 +1,114 @@
+package instances
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+)
+
+// fakeChanges reports the attributes in it as changed
+type fakeChanges map[string]bool
+
+func (f fakeChanges) HasChange(key string) bool {
+	return f[key]
+}
+
+func TestChangedRebootAttributes(t *testing.T) {
+	changes := fakeChanges{"size": true, "notes": true}
+
+	got := changedRebootAttributes(changes, []interface{}{"size", "tags"})
+	if !reflect.DeepEqual(got, []string{"size"}) {
+		t.Errorf("expected only size to trigger a reboot, got %v", got)
+	}
+
+	if got := changedRebootAttributes(changes, []interface{}{}); len(got) != 0 {
+		t.Errorf("expected no reboot by default, got %v", got)
+	}
+}
+
+func TestRebootInstance(t *testing.T) {
+	var mu sync.Mutex
+	status := "ACTIVE"
+	rebootCalled := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v2/instances/12345/soft_reboots":
+			rebootCalled = true
+			status = "REBOOTING"
+			rw.Write([]byte(`{"result": "success"}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/instances/12345":
+			rw.Write([]byte(`{"id": "12345", "hostname": "foo.example.com", "status": "` + status + `"}`))
+			// the instance comes back on the next poll
+			status = "ACTIVE"
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	if err := rebootInstance(context.Background(), &utils.CombinedConfig{Client: client}, client, "12345", time.Minute); err != nil {
+		t.Errorf("expected the instance to reboot, got %s", err)
+	}
+
+	if !rebootCalled {
+		t.Errorf("expected the instance to be rebooted")
+	}
+}
+
+func TestRebootInstanceWaitsForTheRebootToStart(t *testing.T) {
+	var mu sync.Mutex
+	// the instance is still ACTIVE right after the reboot is requested
+	statuses := []string{"ACTIVE", "REBOOTING", "REBOOTING", "ACTIVE"}
+	seen := []string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case req.Method == http.MethodPost && req.URL.Path == "/v2/instances/12345/soft_reboots":
+			rw.Write([]byte(`{"result": "success"}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/instances/12345":
+			status := statuses[0]
+			if len(statuses) > 1 {
+				statuses = statuses[1:]
+			}
+			seen = append(seen, status)
+			rw.Write([]byte(`{"id": "12345", "hostname": "foo.example.com", "status": "` + status + `"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	if err := rebootInstance(context.Background(), &utils.CombinedConfig{Client: client}, client, "12345", time.Minute); err != nil {
+		t.Fatalf("expected the instance to reboot, got %s", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !reflect.DeepEqual(seen, []string{"ACTIVE", "REBOOTING", "REBOOTING", "ACTIVE"}) {
+		t.Errorf("expected the wait to only end once the instance is ACTIVE after rebooting, got %v", seen)
+	}
+}
@@ -0,0 +1,39 @@
+package instances
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// resizeDiskWarning warns when a resize grows the root disk of the instance, the new space isn't
+// necessarily usable until the root filesystem is extended. The warning is only advisory so it
+// is skipped when the sizes can't be found
+func resizeDiskWarning(apiClient *civogo.Client, oldSize, newSize string) diag.Diagnostics {
+	sizes, err := cachedInstanceSizes(apiClient)
+	if err != nil {
+		log.Printf("[WARN] unable to list the instance sizes to compare the disks: %s", err)
+		return nil
+	}
+
+	disks := map[string]int{}
+	for _, size := range sizes {
+		disks[size.Name] = size.DiskGigabytes
+	}
+
+	oldDisk, oldFound := disks[oldSize]
+	newDisk, newFound := disks[newSize]
+	if !oldFound || !newFound || newDisk <= oldDisk {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "The root disk of the instance grows",
+			Detail:   fmt.Sprintf("Resizing from %s to %s grows the root disk from %d GB to %d GB, the root filesystem may have to be extended before the new space can be used.", oldSize, newSize, oldDisk, newDisk),
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package instances
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func fakeSizesServer(t *testing.T) (*civogo.Client, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v2/sizes" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write([]byte(`[
+			{"name": "g3.small", "disk_gb": 25},
+			{"name": "g3.medium", "disk_gb": 50},
+			{"name": "g3.large", "disk_gb": 100},
+			{"name": "g4s.medium", "disk_gb": 50}
+		]`))
+	}))
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	return client, server
+}
+
+func TestResizeDiskWarning(t *testing.T) {
+	client, server := fakeSizesServer(t)
+	defer server.Close()
+
+	cases := []struct {
+		name    string
+		oldSize string
+		newSize string
+		warning bool
+	}{
+		{"disk grows", "g3.small", "g3.large", true},
+		{"same disk", "g3.medium", "g4s.medium", false},
+		{"disk shrinks", "g3.large", "g3.medium", false},
+		{"unknown size", "g3.small", "g9.huge", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := resizeDiskWarning(client, tc.oldSize, tc.newSize)
+
+			if !tc.warning {
+				if len(diags) != 0 {
+					t.Errorf("expected no warning, got %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 || diags[0].Severity != diag.Warning {
+				t.Fatalf("expected a single warning, got %v", diags)
+			}
+			if expected := "Resizing from g3.small to g3.large grows the root disk from 25 GB to 100 GB, the root filesystem may have to be extended before the new space can be used."; diags[0].Detail != expected {
+				t.Errorf("unexpected warning %q", diags[0].Detail)
+			}
+		})
+	}
+}
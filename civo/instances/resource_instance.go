@@ -3,6 +3,7 @@ package instances
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"log"
 	"strings"
@@ -25,15 +26,27 @@ func ResourceInstance() *schema.Resource {
 			"region": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Description: "The region for the instance, if not declare we use the region in declared in the provider",
 			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uniform resource name (URN) of the instance, in the form `civo:instance:<region>:<id>`",
+			},
 			"hostname": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "A fully qualified domain name that should be set as the instance's hostname",
-				ValidateFunc: utils.ValidateNameSize,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "A fully qualified domain name that should be set as the instance's hostname. If Civo appends a numeric suffix to the hostname sent by Terraform because it's already in use (e.g. `web-1`), or stores a hostname sent without a domain with a domain suffix (e.g. `web.example.com`), the suffixed hostname is kept in state without showing a diff. An imported instance keeps the hostname the API returns, suffix included, so the configuration has to use the full hostname",
+				ValidateFunc:     utils.ValidateNameSize,
+				DiffSuppressFunc: suppressServerHostnameSuffix,
+			},
+			"requested_hostname": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hostname Terraform last sent to the API, used to tell a suffix applied by Civo from a rename",
 			},
 			"reverse_dns": {
 				Type:         schema.TypeString,
@@ -83,20 +96,26 @@ func ResourceInstance() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
+				ForceNew:     true,
 				ValidateFunc: utils.ValidateUUID,
-				Description:  "The ID of an already uploaded SSH public key to use for login to the default user (optional; if one isn't provided a random password will be set and returned in the initial_password field)",
+				Description:  "The ID of an already uploaded SSH public key to use for login to the default user (optional; if one isn't provided the `default_sshkey_id` of the provider is used, and without one a random password will be set and returned in the initial_password field). The Civo API can't change the key of a running instance, so changing it recreates the instance",
 			},
 			"firewall_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: utils.ValidateUUID,
-				Description:  "The ID of the firewall to use, from the current list. If left blank or not sent, the default firewall will be used (open to all)",
+				Description:  "The ID of the firewall to use, from the current list. If not set, the firewall mapped to the tags of the instance in the `tag_firewall_map` of the provider is used, then the `default_firewall_id` of the provider, one of them must be set",
 			},
 			"tags": {
 				Type:        schema.TypeSet,
 				Optional:    true,
-				Description: "An optional list of tags, represented as a key, value pair",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				MaxItems:    utils.MaxTags,
+				Description: fmt.Sprintf("An optional list of tags, represented as a key, value pair (at most %d tags of up to %d characters each)", utils.MaxTags, utils.MaxTagLength),
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: utils.ValidateTag,
+				},
 			},
 			"script": {
 				Type:     schema.TypeString,
@@ -131,6 +150,11 @@ func ResourceInstance() *schema.Resource {
 				Computed:    true,
 				Description: "Instance's source ID",
 			},
+			"source_snapshot_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the snapshot the instance was created from, empty if it wasn't created from a snapshot. It can't change once the instance exists",
+			},
 			"initial_password": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -154,6 +178,63 @@ func ResourceInstance() *schema.Resource {
 				Computed:    true,
 				Description: "Instance's public IP address",
 			},
+			"public_ipv6": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Instance's public IPv6 address, empty if the instance has no IPv6 address",
+			},
+			"effective_firewall_rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rules of the firewall currently applied to the instance, when `firewall_id` changes the plan shows the rules of the new firewall",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the rule",
+						},
+						"direction": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The direction of the rule, `ingress` or `egress`",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The label of the rule",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The protocol of the rule",
+						},
+						"port_range": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ports the rule applies to",
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The action of the rule, `allow` or `deny`",
+						},
+						"cidr": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The CIDRs the rule applies to",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"firewall_rule_changes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The firewall rules added and removed by the last change of `firewall_id`, e.g. `added allow ingress tcp 443 from 0.0.0.0/0 (https)`, the plan shows the ones a pending change brings",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"volumes": instanceVolumesSchema(),
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -162,7 +243,7 @@ func ResourceInstance() *schema.Resource {
 			"created_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Timestamp when the instance was created",
+				Description: "Timestamp when the instance was created, in RFC3339 format",
 			},
 			"private_ipv4": {
 				Type:        schema.TypeString,
@@ -173,7 +254,28 @@ func ResourceInstance() *schema.Resource {
 			"reserved_ipv4": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Can be either the UUID, name, or the IP address of the reserved IP",
+				Description: "Can be either the UUID, name, or the IP address of the reserved IP, when the instance has a different reserved IP or none the address it has is read back. Don't combine it with `civo_instance_reserved_ip_assignment` for the same instance",
+			},
+			"graceful_shutdown": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to shut down the instance before destroying it, if it doesn't stop within half of the delete timeout (at most 5 minutes) it is deleted anyway (default: true)",
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to refuse destroying the instance, it has to be set to false and applied before the instance can be destroyed (default: false)",
+			},
+			"reboot_on_change": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of attributes which reboot the instance when they change, after the change is applied the instance is soft rebooted and the update waits until it is active again. One or more of " + utils.GetCommaSeparatedAllowedKeys(rebootOnChangeAttributes) + " (by default the instance is never rebooted)",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(rebootOnChangeAttributes, false),
+				},
 			},
 		},
 		CreateContext: resourceInstanceCreate,
@@ -181,24 +283,74 @@ func ResourceInstance() *schema.Resource {
 		UpdateContext: resourceInstanceUpdate,
 		DeleteContext: resourceInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceInstanceImport,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+		CustomizeDiff: customizeDiffInstance,
 	}
 }
 
+// function to check at plan time that the size of an instance is available in its region, that a new
+// instance fits in the account quota and that its region isn't out of capacity, and to show the rules
+// an existing instance gets when its firewall changes
+func customizeDiffInstance(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config, ok := meta.(*utils.CombinedConfig)
+	if !ok {
+		return nil
+	}
+
+	apiClient := utils.ClientForRegion(config, d.Get("region").(string))
+	if d.HasChange("size") && d.NewValueKnown("size") {
+		if err := validateInstanceSize(apiClient, d.Get("size").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.Id() != "" {
+		return customizeDiffInstanceFirewall(d, apiClient)
+	}
+
+	if !config.SkipCapacityCheck {
+		if err := utils.ValidateRegionCapacity(apiClient, apiClient.Region); err != nil {
+			return fmt.Errorf("the instance %s can not be created, %s", d.Get("hostname").(string), err)
+		}
+	}
+
+	if config.SkipQuotaCheck {
+		return nil
+	}
+
+	size := d.Get("size").(string)
+	if size == "" {
+		return nil
+	}
+
+	if err := utils.ValidateQuota(apiClient, map[string]int{size: 1}); err != nil {
+		return fmt.Errorf("the instance %s can not be created, %s", d.Get("hostname").(string), err)
+	}
+
+	return nil
+}
+
 // function to create an instance
 func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
+
+	firewallID, err := instanceFirewallID(d, m.(*utils.CombinedConfig).TagFirewalls, m.(*utils.CombinedConfig).DefaultFirewallID)
+	if err != nil {
+		return diag.Errorf("[ERR] %s", err)
+	}
 
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	// wait for our turn when the provider limits the instances created at the same time
+	instanceCreates := m.(*utils.CombinedConfig).InstanceCreates
+	if err := instanceCreates.Acquire(ctx); err != nil {
+		return diag.Errorf("[ERR] cancelled while waiting to create the instance %s: %s", d.Get("hostname").(string), err)
 	}
+	defer instanceCreates.Release()
 
 	log.Printf("[INFO] configuring the instance %s", d.Get("hostname").(string))
 	config := &civogo.InstanceConfig{
@@ -258,8 +410,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 		config.InitialUser = attr.(string)
 	}
 
-	if attr, ok := d.GetOk("sshkey_id"); ok {
-		config.SSHKeyID = attr.(string)
+	if sshKeyID := instanceSSHKeyID(d, m.(*utils.CombinedConfig).DefaultSSHKeyID); sshKeyID != "" {
+		config.SSHKeyID = sshKeyID
 	}
 
 	if attr, ok := d.GetOk("script"); ok {
@@ -286,20 +438,22 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	d.SetId(instance.ID)
+	d.Set("requested_hostname", config.Hostname)
 
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{"BUILDING"},
 		Target:  []string{"ACTIVE"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("instance %s", d.Id()), func() (interface{}, string, error) {
 			resp, err := apiClient.GetInstance(d.Id())
 			if err != nil {
 				return 0, "", err
 			}
 			return resp, resp.Status, nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 60,
 	}
 	_, err = createStateConf.WaitForStateContext(ctx)
@@ -307,11 +461,9 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.Errorf("error waiting for instance (%s) to be created: %s", d.Id(), err)
 	}
 
-	if attr, ok := d.GetOk("firewall_id"); ok {
-		_, errInstance := apiClient.SetInstanceFirewall(d.Id(), attr.(string))
-		if errInstance != nil {
-			return diag.Errorf("[ERR] updating instance firewall: %s", err)
-		}
+	errInstance := setInstanceFirewall(ctx, apiClient, d.Id(), firewallID, firewallReadyTimeout)
+	if errInstance != nil {
+		return diag.Errorf("[ERR] updating instance firewall: %s", errInstance)
 	}
 
 	if attr, ok := d.GetOk("notes"); ok {
@@ -332,12 +484,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 // function to read the instance
 func resourceInstanceRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] retriving the instance %s", d.Id())
 	resp, err := apiClient.GetInstance(d.Id())
@@ -362,36 +509,76 @@ func resourceInstanceRead(_ context.Context, d *schema.ResourceData, m interface
 	}
 
 	d.Set("hostname", resp.Hostname)
+	// imported instances and the ones created before the hostname sent was recorded
+	if d.Get("requested_hostname").(string) == "" {
+		d.Set("requested_hostname", resp.Hostname)
+	}
+	d.Set("region", utils.RegionFromResponse(resp.Region, apiClient))
+	d.Set("urn", utils.URN("instance", utils.RegionFromResponse(resp.Region, apiClient), d.Id()))
 	d.Set("reverse_dns", resp.ReverseDNS)
 	d.Set("size", resp.Size)
-	d.Set("cpu_cores", resp.CPUCores)
-	d.Set("ram_mb", resp.RAMMegabytes)
-	d.Set("disk_gb", resp.DiskGigabytes)
+	cpuCores, ramMegabytes, diskGigabytes := instanceSpecs(apiClient, resp)
+	d.Set("cpu_cores", cpuCores)
+	d.Set("ram_mb", ramMegabytes)
+	d.Set("disk_gb", diskGigabytes)
 	d.Set("initial_user", resp.InitialUser)
 	d.Set("source_type", resp.SourceType)
 	d.Set("source_id", resp.SourceID)
+	d.Set("source_snapshot_id", resp.SnapshotID)
 	d.Set("sshkey_id", resp.SSHKeyID)
 	d.Set("tags", resp.Tags)
 	d.Set("private_ip", resp.PrivateIP)
 	d.Set("public_ip", resp.PublicIP)
+	d.Set("public_ipv6", resp.IPv6)
 	d.Set("network_id", resp.NetworkID)
 	d.Set("firewall_id", resp.FirewallID)
+
+	rules, err := effectiveFirewallRules(apiClient, resp.FirewallID)
+	if err != nil {
+		return diag.Errorf("[ERR] failed to retrieve the rules of the firewall %s: %s", resp.FirewallID, err)
+	}
+	if err := d.Set("effective_firewall_rules", rules); err != nil {
+		return diag.Errorf("[ERR] error setting the effective firewall rules of the instance: %s", err)
+	}
+
+	volumes, err := attachedVolumes(apiClient, d.Id())
+	if err != nil {
+		return diag.Errorf("[ERR] failed to list the volumes of the instance: %s", err)
+	}
+	if err := d.Set("volumes", volumes); err != nil {
+		return diag.Errorf("[ERR] error setting the volumes of the instance: %s", err)
+	}
+
 	d.Set("status", resp.Status)
 	d.Set("script", resp.Script)
-	d.Set("created_at", resp.CreatedAt.UTC().String())
+	d.Set("created_at", utils.FormatTime(resp.CreatedAt))
 	d.Set("notes", resp.Notes)
 	d.Set("disk_image", diskImg.ID)
 
+	// graceful_shutdown and deletion_protection are missing from the state of the instances imported
+	// or created before they were added, set their default so upgrading the provider plans no change
+	if _, ok := d.GetOkExists("graceful_shutdown"); !ok {
+		d.Set("graceful_shutdown", true)
+	}
+	if _, ok := d.GetOkExists("deletion_protection"); !ok {
+		d.Set("deletion_protection", false)
+	}
+
 	if resp.PublicIP != "" {
 		d.Set("public_ip_required", "create")
 	} else {
 		d.Set("public_ip_required", "none")
 	}
 
-	if d.HasChange("reserved_ipv4") {
-		_, new := d.GetChange("reserved_ipv4")
-		newValue := new.(string)
-		d.Set("reserved_ipv4", newValue)
+	// reserved_ipv4 can be the UUID, name or address of the reserved IP, it's kept as configured
+	// while the same IP is assigned. An instance without it in the state may have its IP managed by
+	// civo_instance_reserved_ip_assignment, so it's only reconciled when the instance manages it
+	if reservedIP := d.Get("reserved_ipv4").(string); reservedIP != "" {
+		switch reservedIP {
+		case resp.ReservedIP, resp.ReservedIPID, resp.ReservedIPName:
+		default:
+			d.Set("reserved_ipv4", resp.ReservedIP)
+		}
 	}
 
 	return nil
@@ -399,15 +586,13 @@ func resourceInstanceRead(_ context.Context, d *schema.ResourceData, m interface
 
 // function to update an instance
 func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	var diags diag.Diagnostics
 
 	// check if the size change if change we send to resize the instance
 	if d.HasChange("size") {
+		oldSize, _ := d.GetChange("size")
 		newSize := d.Get("size").(string)
 
 		log.Printf("[INFO] resizing the instance %s", d.Id())
@@ -419,22 +604,25 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		createStateConf := &resource.StateChangeConf{
 			Pending: []string{"BUILDING"},
 			Target:  []string{"ACTIVE"},
-			Refresh: func() (interface{}, string, error) {
+			Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("instance %s", d.Id()), func() (interface{}, string, error) {
 				resp, err := apiClient.GetInstance(d.Id())
 				if err != nil {
 					return 0, "", err
 				}
 				return resp, resp.Status, nil
-			},
+			})),
 			Timeout:        60 * time.Minute,
-			Delay:          3 * time.Second,
-			MinTimeout:     3 * time.Second,
+			Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+			MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+			PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 			NotFoundChecks: 60,
 		}
 		_, err = createStateConf.WaitForStateContext(ctx)
 		if err != nil {
 			return diag.Errorf("error waiting for instance (%s) to be created: %s", d.Id(), err)
 		}
+
+		diags = append(diags, resizeDiskWarning(apiClient, oldSize.(string), newSize)...)
 	}
 
 	// if notes or hostname have changed, add them to the instance
@@ -460,6 +648,9 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		if err != nil {
 			return diag.Errorf("[ERR] an error occurred while updating notes or hostname of the instance %s", d.Id())
 		}
+		if d.HasChange("hostname") {
+			d.Set("requested_hostname", hostname)
+		}
 	}
 
 	// If reserved_ipv4 has changed, update the instance with the new reserved IP
@@ -517,10 +708,10 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		firewallID := d.Get("firewall_id").(string)
 
 		log.Printf("[INFO] adding firewall to the instance %s", d.Id())
-		_, err := apiClient.SetInstanceFirewall(d.Id(), firewallID)
+		err := setInstanceFirewall(ctx, apiClient, d.Id(), firewallID, firewallReadyTimeout)
 		if err != nil {
 			// check if the instance no longer exists.
-			return diag.Errorf("[ERR] an error occurred while set firewall to the instance %s", d.Id())
+			return diag.Errorf("[ERR] an error occurred while set firewall to the instance %s: %s", d.Id(), err)
 		}
 	}
 
@@ -528,10 +719,6 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.Errorf("[ERR] updating initial_user is not supported")
 	}
 
-	if d.HasChange("sshkey_id") {
-		return diag.Errorf("[ERR] updating sshkey_id is not supported")
-	}
-
 	// if tags is declare we update the instance with the tags
 	if d.HasChange("tags") {
 		tfTags := d.Get("tags").(*schema.Set).List()
@@ -556,16 +743,31 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	}
 
-	return resourceInstanceRead(ctx, d, m)
+	// reboot the instance if any of the attributes which need it have changed
+	if changed := changedRebootAttributes(d, d.Get("reboot_on_change").(*schema.Set).List()); len(changed) > 0 {
+		log.Printf("[INFO] %s changed on the instance %s, rebooting it", strings.Join(changed, ", "), d.Id())
+		err := rebootInstance(ctx, m.(*utils.CombinedConfig), apiClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("[ERR] an error occurred while rebooting the instance %s: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInstanceRead(ctx, d, m)...)
 }
 
 // function to delete instance.
 func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("[ERR] the instance %s has deletion_protection enabled, set it to false and apply before destroying it", d.Id())
+	}
+
+	if d.Get("graceful_shutdown").(bool) {
+		err := shutdownInstance(ctx, m.(*utils.CombinedConfig), apiClient, d.Id(), gracefulShutdownTimeout(d.Timeout(schema.TimeoutDelete)))
+		if err != nil {
+			log.Printf("[WARN] graceful shutdown of the instance %s failed, forcing the delete: %s", d.Id(), err)
+		}
 	}
 
 	log.Printf("[INFO] deleting the instance %s", d.Id())
@@ -578,7 +780,7 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, m inter
 	deleteStateConf := &retry.StateChangeConf{
 		Pending: []string{"DELETING"},
 		Target:  []string{"DELETED"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("instance %s", d.Id()), func() (interface{}, string, error) {
 			resp, err := apiClient.GetInstance(d.Id())
 			if err != nil {
 				if errors.Is(err, civogo.DatabaseInstanceNotFoundError) {
@@ -587,10 +789,11 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, m inter
 				return 0, "", err
 			}
 			return resp, resp.Status, nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 60,
 	}
 	_, err = deleteStateConf.WaitForStateContext(ctx)
@@ -600,3 +803,21 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, m inter
 
 	return nil
 }
+
+// custom import to keep the reserved IP held by the instance, otherwise the public IP
+// would look ephemeral and the next plan would try to unassign the reserved IP
+func resourceInstanceImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
+
+	log.Printf("[INFO] retriving the instance %s", d.Id())
+	resp, err := apiClient.GetInstance(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to retriving the instance: %s", err)
+	}
+
+	if resp.ReservedIP != "" {
+		d.Set("reserved_ipv4", resp.ReservedIP)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,381 @@
+package instances
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// fakeFirewallRules are the rules of every firewall served by fakeInstanceReadServer
+const fakeFirewallRules = `[
+	{"id": "rule-2", "protocol": "tcp", "start_port": "443", "ports": "443", "cidr": ["0.0.0.0/0"], "direction": "ingress", "action": "allow", "label": "https"},
+	{"id": "rule-3", "protocol": "tcp", "start_port": "1", "ports": "1-65535", "cidr": ["0.0.0.0/0"], "direction": "egress", "action": "allow"},
+	{"id": "rule-1", "protocol": "tcp", "start_port": "22", "ports": "22", "cidr": ["10.0.0.0/8", "192.168.0.0/16"], "direction": "ingress", "action": "allow", "label": "ssh"}
+]`
+
+// fakeVolumes are the volumes of the region served by fakeInstanceReadServer, two of them attached to the instance 12345
+const fakeVolumes = `[
+	{"id": "volume-2", "name": "logs", "instance_id": "12345", "mountpoint": "/dev/vdc", "size_gb": 50},
+	{"id": "volume-3", "name": "other", "instance_id": "67890", "mountpoint": "/dev/vdb", "size_gb": 10},
+	{"id": "volume-1", "name": "data", "instance_id": "12345", "mountpoint": "/dev/vdb", "size_gb": 20}
+]`
+
+// fakeInstanceReadServer serves the instance 12345 with the given JSON and the disk image it was built from
+func fakeInstanceReadServer(t *testing.T, instance string) (*utils.CombinedConfig, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/instances/12345":
+			rw.Write([]byte(instance))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/disk_images":
+			rw.Write([]byte(`[{"id": "b82168fe-66f6-4b4d-a4d2-2d8dbd4ad3e5", "name": "debian-11"}]`))
+		case req.Method == http.MethodGet && strings.HasPrefix(req.URL.Path, "/v2/firewalls/") && strings.HasSuffix(req.URL.Path, "/rules"):
+			rw.Write([]byte(fakeFirewallRules))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/volumes":
+			rw.Write([]byte(fakeVolumes))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	return &utils.CombinedConfig{Client: client}, server
+}
+
+func TestResourceInstanceReadSourceSnapshot(t *testing.T) {
+	config, server := fakeInstanceReadServer(t, `{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "source_type": "diskimage", "source_id": "debian-11", "snapshot_id": "snapshot-id"}`)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{})
+	d.SetId("12345")
+
+	// the source snapshot is captured and stays the same on every refresh
+	for i := 0; i < 2; i++ {
+		if diags := resourceInstanceRead(context.Background(), d, config); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := d.Get("source_snapshot_id").(string); got != "snapshot-id" {
+			t.Errorf("expected the source snapshot ID to be captured, got %q", got)
+		}
+	}
+}
+
+func TestResourceInstanceReadNetworkDefaultFirewall(t *testing.T) {
+	// the API applied the default firewall of the network instead of the one in the state
+	config, server := fakeInstanceReadServer(t, `{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "source_id": "debian-11", "network_id": "network-id", "firewall_id": "default-firewall-id"}`)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{
+		"firewall_id": "18bd98ad-1b6e-4f87-b48f-e690b4fd7413",
+	})
+	d.SetId("12345")
+
+	if diags := resourceInstanceRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("firewall_id").(string); got != "default-firewall-id" {
+		t.Errorf("expected the firewall applied to the instance, got %q", got)
+	}
+}
+
+func TestResourceInstanceReadIPv6(t *testing.T) {
+	cases := []struct {
+		name     string
+		instance string
+		expected string
+	}{
+		{
+			name:     "IPv6 enabled",
+			instance: `{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "source_id": "debian-11", "public_ip": "74.220.1.1", "ipv6": "2a0a:4cc0:1::1"}`,
+			expected: "2a0a:4cc0:1::1",
+		},
+		{
+			name:     "IPv6 disabled",
+			instance: `{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "source_id": "debian-11", "public_ip": "74.220.1.1"}`,
+			expected: "",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config, server := fakeInstanceReadServer(t, c.instance)
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{})
+			d.SetId("12345")
+
+			if diags := resourceInstanceRead(context.Background(), d, config); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got := d.Get("public_ipv6").(string); got != c.expected {
+				t.Errorf("expected public_ipv6 %q, got %q", c.expected, got)
+			}
+			if got := d.Get("public_ip").(string); got != "74.220.1.1" {
+				t.Errorf("expected the IPv4 address to be kept, got %q", got)
+			}
+		})
+	}
+}
+
+func TestResourceInstanceReadEffectiveFirewallRules(t *testing.T) {
+	config, server := fakeInstanceReadServer(t, `{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "source_id": "debian-11", "firewall_id": "firewall-id"}`)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{})
+	d.SetId("12345")
+
+	if diags := resourceInstanceRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	rules := d.Get("effective_firewall_rules").([]interface{})
+	if len(rules) != 3 {
+		t.Fatalf("expected the 3 rules of the firewall, got %d", len(rules))
+	}
+
+	// the rules are sorted by direction and then ports
+	expected := []string{"rule-3", "rule-1", "rule-2"}
+	for i, rule := range rules {
+		if got := rule.(map[string]interface{})["id"]; got != expected[i] {
+			t.Errorf("expected rule %s at position %d, got %s", expected[i], i, got)
+		}
+	}
+
+	ssh := rules[1].(map[string]interface{})
+	if ssh["direction"] != "ingress" || ssh["port_range"] != "22" || ssh["label"] != "ssh" || len(ssh["cidr"].([]interface{})) != 2 {
+		t.Errorf("unexpected ssh rule %v", ssh)
+	}
+}
+
+func TestResourceInstanceReadNoFirewall(t *testing.T) {
+	config, server := fakeInstanceReadServer(t, `{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "source_id": "debian-11"}`)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{})
+	d.SetId("12345")
+
+	if diags := resourceInstanceRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if rules := d.Get("effective_firewall_rules").([]interface{}); len(rules) != 0 {
+		t.Errorf("expected no rules for an instance without a firewall, got %v", rules)
+	}
+}
+
+func TestResourceInstanceReadOtherRegion(t *testing.T) {
+	// the instance only exists in NYC1, while the provider and the shared client point to LON1
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("region") != "NYC1" {
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"code": "database_instance_not_found", "reason": "The instance could not be found"}`))
+			return
+		}
+
+		switch req.URL.Path {
+		case "/v2/instances/12345":
+			rw.Write([]byte(`{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "source_id": "debian-11"}`))
+		case "/v2/disk_images":
+			rw.Write([]byte(`[{"id": "b82168fe-66f6-4b4d-a4d2-2d8dbd4ad3e5", "name": "debian-11"}]`))
+		case "/v2/volumes":
+			rw.Write([]byte(`[]`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+	client.Region = "LON1"
+	config := &utils.CombinedConfig{Client: client, Region: "LON1"}
+
+	d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{"region": "NYC1"})
+	d.SetId("12345")
+
+	if diags := resourceInstanceRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "12345" {
+		t.Fatalf("expected the instance to be found in its own region")
+	}
+
+	if got := d.Get("region").(string); got != "NYC1" {
+		t.Errorf("expected the region NYC1, got %q", got)
+	}
+
+	if got := d.Get("urn").(string); got != "civo:instance:nyc1:12345" {
+		t.Errorf("expected the URN civo:instance:nyc1:12345, got %q", got)
+	}
+
+	if client.Region != "LON1" {
+		t.Errorf("expected the shared client to keep its region, got %q", client.Region)
+	}
+}
+
+func TestResourceInstanceReadVolumes(t *testing.T) {
+	config, server := fakeInstanceReadServer(t, `{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "source_id": "debian-11"}`)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{})
+	d.SetId("12345")
+
+	if diags := resourceInstanceRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{
+		{"id": "volume-1", "name": "data", "size_gb": 20, "device_path": "/dev/vdb"},
+		{"id": "volume-2", "name": "logs", "size_gb": 50, "device_path": "/dev/vdc"},
+	}
+
+	volumes := d.Get("volumes").([]interface{})
+	if len(volumes) != len(expected) {
+		t.Fatalf("expected %d volumes, got %d", len(expected), len(volumes))
+	}
+	for i, volume := range volumes {
+		if !reflect.DeepEqual(volume.(map[string]interface{}), expected[i]) {
+			t.Errorf("expected the volume %d to be %v, got %v", i, expected[i], volume)
+		}
+	}
+}
+
+func TestResourceInstanceImportHostnameDomainSuffix(t *testing.T) {
+	// the instance was created with the hostname foo, the API returns it with a domain
+	config, server := fakeInstanceReadServer(t, `{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "source_id": "debian-11"}`)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{})
+	d.SetId("12345")
+
+	imported, err := resourceInstanceImport(context.Background(), d, config)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diags := resourceInstanceRead(context.Background(), imported[0], config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	hostname := imported[0].Get("hostname").(string)
+	if hostname != "foo.example.com" {
+		t.Errorf("expected the hostname to be kept as the API returns it, got %q", hostname)
+	}
+	if got := imported[0].Get("requested_hostname").(string); got != "foo.example.com" {
+		t.Errorf("expected the imported hostname to be taken as the requested one, got %q", got)
+	}
+
+	// the suffix of an imported hostname is preserved, the configuration plans clean with the
+	// full hostname, while the short one is a rename
+	if !suppressServerHostnameSuffix("hostname", hostname, "foo.example.com", imported[0]) {
+		t.Errorf("expected no diff between %q and the configured full hostname", hostname)
+	}
+	if suppressServerHostnameSuffix("hostname", hostname, "foo", imported[0]) {
+		t.Errorf("expected a diff between %q and the configured hostname foo", hostname)
+	}
+}
+
+func TestResourceInstanceReadKeepsRequestedHostname(t *testing.T) {
+	// the instance was created with the hostname foo, Civo stored it with a suffix
+	config, server := fakeInstanceReadServer(t, `{"id": "12345", "hostname": "foo-1.example.com", "status": "ACTIVE", "source_id": "debian-11"}`)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{})
+	d.SetId("12345")
+	d.Set("requested_hostname", "foo")
+
+	if diags := resourceInstanceRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("requested_hostname").(string); got != "foo" {
+		t.Errorf("expected the requested hostname to be kept, got %q", got)
+	}
+	if !suppressServerHostnameSuffix("hostname", d.Get("hostname").(string), "foo", d) {
+		t.Errorf("expected no diff between %q and the requested hostname foo", d.Get("hostname").(string))
+	}
+}
+
+func TestResourceInstanceReadDefaultsMissingAttributes(t *testing.T) {
+	config, server := fakeInstanceReadServer(t, `{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "source_id": "debian-11"}`)
+	defer server.Close()
+
+	// the state of an instance created before graceful_shutdown and deletion_protection were added
+	d := ResourceInstance().Data(&terraform.InstanceState{
+		ID:         "12345",
+		Attributes: map[string]string{"id": "12345", "hostname": "foo.example.com"},
+	})
+
+	if diags := resourceInstanceRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("graceful_shutdown").(bool); !got {
+		t.Errorf("expected graceful_shutdown to default to true")
+	}
+	if _, ok := d.GetOkExists("deletion_protection"); !ok {
+		t.Errorf("expected deletion_protection to be set")
+	}
+
+	// a value already in the state is kept
+	d.Set("graceful_shutdown", false)
+	if diags := resourceInstanceRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := d.Get("graceful_shutdown").(bool); got {
+		t.Errorf("expected graceful_shutdown to stay false")
+	}
+}
+
+func TestResourceInstanceReadReservedIP(t *testing.T) {
+	cases := []struct {
+		name     string
+		assigned string
+		state    string
+		expected string
+	}{
+		{name: "address kept", assigned: `"reserved_ip": "1.2.3.4", "reserved_ip_id": "ip-id", "reserved_ip_name": "web-ip"`, state: "1.2.3.4", expected: "1.2.3.4"},
+		{name: "name kept", assigned: `"reserved_ip": "1.2.3.4", "reserved_ip_id": "ip-id", "reserved_ip_name": "web-ip"`, state: "web-ip", expected: "web-ip"},
+		{name: "ID kept", assigned: `"reserved_ip": "1.2.3.4", "reserved_ip_id": "ip-id", "reserved_ip_name": "web-ip"`, state: "ip-id", expected: "ip-id"},
+		{name: "other IP assigned", assigned: `"reserved_ip": "5.6.7.8", "reserved_ip_id": "other-id", "reserved_ip_name": "other-ip"`, state: "web-ip", expected: "5.6.7.8"},
+		{name: "unassigned", assigned: `"reserved_ip": ""`, state: "web-ip", expected: ""},
+		// the IP may be managed by civo_instance_reserved_ip_assignment
+		{name: "not managed by the instance", assigned: `"reserved_ip": "1.2.3.4", "reserved_ip_id": "ip-id", "reserved_ip_name": "web-ip"`, state: "", expected: ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config, server := fakeInstanceReadServer(t, `{"id": "12345", "hostname": "foo.example.com", "status": "ACTIVE", "source_id": "debian-11", `+c.assigned+`}`)
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, ResourceInstance().Schema, map[string]interface{}{"reserved_ipv4": c.state})
+			d.SetId("12345")
+
+			if diags := resourceInstanceRead(context.Background(), d, config); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got := d.Get("reserved_ipv4").(string); got != c.expected {
+				t.Errorf("expected reserved_ipv4 to be %q, got %q", c.expected, got)
+			}
+		})
+	}
+}
@@ -2,10 +2,11 @@ package instances
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
-	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -47,12 +48,7 @@ func ResourceInstanceReservedIPAssignment() *schema.Resource {
 
 // function to create a instance
 func resourceInstanceReservedIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	// We check if the instance is valid and if it is not we return an error
 	instance, err := apiClient.GetInstance(d.Get("instance_id").(string))
@@ -83,7 +79,7 @@ func resourceInstanceReservedIPCreate(ctx context.Context, d *schema.ResourceDat
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{"PENDING"},
 		Target:  []string{"ASSIGNED"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("reserved IP %s", reservedIP.ID), func() (interface{}, string, error) {
 			resp, err := apiClient.GetInstance(instance.ID)
 			if err != nil {
 				return 0, "", err
@@ -92,10 +88,11 @@ func resourceInstanceReservedIPCreate(ctx context.Context, d *schema.ResourceDat
 				return 0, "PENDING", nil
 			}
 			return resp, "ASSIGNED", nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 60,
 	}
 	_, err = createStateConf.WaitForStateContext(ctx)
@@ -109,12 +106,7 @@ func resourceInstanceReservedIPCreate(ctx context.Context, d *schema.ResourceDat
 
 // function to read the instance
 func resourceInstanceReservedIPRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	instanceID := d.Get("instance_id").(string)
 	reservedID := d.Get("reserved_ip_id").(string)
@@ -135,12 +127,7 @@ func resourceInstanceReservedIPRead(_ context.Context, d *schema.ResourceData, m
 
 // function to delete instance
 func resourceInstanceReservedIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	reservedIP := d.Get("reserved_ip_id").(string)
 
@@ -154,7 +141,7 @@ func resourceInstanceReservedIPDelete(ctx context.Context, d *schema.ResourceDat
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{"PENDING"},
 		Target:  []string{"DONE"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("reserved IP %s", reservedIP), func() (interface{}, string, error) {
 			resp, err := apiClient.FindIP(reservedIP)
 			if err != nil {
 				return 0, "", err
@@ -163,10 +150,11 @@ func resourceInstanceReservedIPDelete(ctx context.Context, d *schema.ResourceDat
 				return 0, "PENDING", nil
 			}
 			return resp, "DONE", nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 60,
 	}
 	_, err = createStateConf.WaitForStateContext(ctx)
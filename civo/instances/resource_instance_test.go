@@ -207,6 +207,81 @@ func TestAccCivoInstanceFirewall_update(t *testing.T) {
 	})
 }
 
+func TestAccCivoInstanceReservedIP_import(t *testing.T) {
+	var instance civogo.Instance
+
+	// generate a random name for each test run
+	resName := "civo_instance.foobar"
+	var instanceHostname = acctest.RandomWithPrefix("tf-test") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoInstanceConfigReservedIP(instanceHostname),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CivoInstanceResourceExists(resName, &instance),
+					resource.TestCheckResourceAttrPair(resName, "reserved_ipv4", "civo_reserved_ip.foobar", "ip"),
+					resource.TestCheckResourceAttrPair(resName, "public_ip", "civo_reserved_ip.foobar", "ip"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"initial_password", "write_password", "disk_image", "timeouts"},
+			},
+		},
+	})
+}
+
+// TestAccCivoInstanceSSHKey_rotate checks that changing the SSH key replaces the instance instead of failing
+func TestAccCivoInstanceSSHKey_rotate(t *testing.T) {
+	var instance, rotatedInstance civogo.Instance
+
+	// generate a random name for each test run
+	resName := "civo_instance.foobar"
+	var instanceHostname = acctest.RandomWithPrefix("tf-test") + ".example"
+	firstPublicKey, _, err := acctest.RandSSHKeyPair("civo@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Cannot generate test SSH key pair: %s", err)
+	}
+	secondPublicKey, _, err := acctest.RandSSHKeyPair("civo@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Cannot generate test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoInstanceConfigSSHKey(instanceHostname, firstPublicKey, secondPublicKey, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CivoInstanceResourceExists(resName, &instance),
+					resource.TestCheckResourceAttrPair(resName, "sshkey_id", "civo_ssh_key.first", "id"),
+				),
+			},
+			{
+				Config: CivoInstanceConfigSSHKey(instanceHostname, firstPublicKey, secondPublicKey, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CivoInstanceResourceExists(resName, &rotatedInstance),
+					resource.TestCheckResourceAttrPair(resName, "sshkey_id", "civo_ssh_key.second", "id"),
+					func(_ *terraform.State) error {
+						if rotatedInstance.ID == instance.ID {
+							return fmt.Errorf("expected the instance to be recreated with the new SSH key")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func CivoInstanceValues(instance *civogo.Instance, name string) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		if instance.Hostname != name {
@@ -353,3 +428,79 @@ resource "civo_instance" "foobar" {
 	firewall_id = civo_firewall.foobar.id
 }`, hostname)
 }
+
+func CivoInstanceConfigReservedIP(hostname string) string {
+	return fmt.Sprintf(`
+data "civo_size" "small" {
+	filter {
+		key = "name"
+		values = ["g3.small"]
+		match_by = "re"
+	}
+
+	filter {
+		key = "type"
+		values = ["instance"]
+	}
+}
+
+# Query instance disk image
+data "civo_disk_image" "debian" {
+	filter {
+		key = "name"
+		values = ["debian-10"]
+	}
+}
+
+resource "civo_reserved_ip" "foobar" {
+	name = "%s"
+}
+
+resource "civo_instance" "foobar" {
+	hostname = "%s"
+	size = element(data.civo_size.small.sizes, 0).name
+	disk_image = element(data.civo_disk_image.debian.diskimages, 0).id
+	reserved_ipv4 = civo_reserved_ip.foobar.ip
+}`, hostname, hostname)
+}
+
+func CivoInstanceConfigSSHKey(hostname, firstPublicKey, secondPublicKey, key string) string {
+	return fmt.Sprintf(`
+data "civo_size" "small" {
+	filter {
+		key = "name"
+		values = ["g3.small"]
+		match_by = "re"
+	}
+
+	filter {
+		key = "type"
+		values = ["instance"]
+	}
+}
+
+# Query instance disk image
+data "civo_disk_image" "debian" {
+	filter {
+		key = "name"
+		values = ["debian-10"]
+	}
+}
+
+resource "civo_ssh_key" "first" {
+	name = "%s-first"
+	public_key = "%s"
+}
+
+resource "civo_ssh_key" "second" {
+	name = "%s-second"
+	public_key = "%s"
+}
+
+resource "civo_instance" "foobar" {
+	hostname = "%s"
+	size = element(data.civo_size.small.sizes, 0).name
+	disk_image = element(data.civo_disk_image.debian.diskimages, 0).id
+	sshkey_id = civo_ssh_key.%s.id
+}`, hostname, firstPublicKey, hostname, secondPublicKey, hostname, key)
+}
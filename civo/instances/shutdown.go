@@ -0,0 +1,63 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// maxGracefulShutdownTimeout is the longest we wait for an instance to stop before deleting it
+const maxGracefulShutdownTimeout = 5 * time.Minute
+
+// gracefulShutdownTimeout returns how long to wait for the instance to stop, it never takes
+// more than half of the delete timeout so there is always time left to delete the instance
+func gracefulShutdownTimeout(deleteTimeout time.Duration) time.Duration {
+	timeout := deleteTimeout / 2
+	if timeout > maxGracefulShutdownTimeout {
+		return maxGracefulShutdownTimeout
+	}
+	return timeout
+}
+
+// shutdownInstance asks the instance to shut down and waits until it is stopped or the timeout expires
+func shutdownInstance(ctx context.Context, config *utils.CombinedConfig, apiClient *civogo.Client, id string, timeout time.Duration) error {
+	instance, err := apiClient.GetInstance(id)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve the instance: %s", err)
+	}
+
+	if instance.Status != "ACTIVE" {
+		log.Printf("[INFO] the instance %s is %s, skipping the graceful shutdown", id, instance.Status)
+		return nil
+	}
+
+	log.Printf("[INFO] shutting down the instance %s", id)
+	if _, err := apiClient.StopInstance(id); err != nil {
+		return fmt.Errorf("failed to shut down the instance: %s", err)
+	}
+
+	stopStateConf := &retry.StateChangeConf{
+		Pending: []string{"ACTIVE", "STOPPING", "SHUTTING_DOWN"},
+		Target:  []string{"SHUTOFF"},
+		Refresh: config.JitterPolls(ctx, config.LogTransitions(ctx, fmt.Sprintf("instance %s", id), func() (interface{}, string, error) {
+			resp, err := apiClient.GetInstance(id)
+			if err != nil {
+				return 0, "", err
+			}
+			return resp, resp.Status, nil
+		})),
+		Timeout:      timeout,
+		MinTimeout:   config.PollDelay(3 * time.Second),
+		PollInterval: config.PollInterval(),
+	}
+	if _, err := stopStateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("the instance didn't shut down in time: %s", err)
+	}
+
+	return nil
+}
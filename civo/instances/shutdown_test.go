@@ -0,0 +1,108 @@
+package instances
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+// fakeInstanceServer serves a single instance, stopOnRequest controls if the instance shuts down when asked to
+func fakeInstanceServer(t *testing.T, stopOnRequest bool) (*civogo.Client, *httptest.Server, *bool) {
+	t.Helper()
+
+	var mu sync.Mutex
+	status := "ACTIVE"
+	stopCalled := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case req.Method == http.MethodPut && req.URL.Path == "/v2/instances/12345/stop":
+			stopCalled = true
+			if stopOnRequest {
+				status = "SHUTOFF"
+			}
+			rw.Write([]byte(`{"result": "success"}`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/instances/12345":
+			rw.Write([]byte(fmt.Sprintf(`{"id": "12345", "hostname": "foo.example.com", "status": "%s"}`, status)))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	return client, server, &stopCalled
+}
+
+func TestShutdownInstanceGraceful(t *testing.T) {
+	client, server, stopCalled := fakeInstanceServer(t, true)
+	defer server.Close()
+
+	if err := shutdownInstance(context.Background(), &utils.CombinedConfig{Client: client}, client, "12345", time.Second); err != nil {
+		t.Errorf("expected the instance to shut down, got %s", err)
+	}
+
+	if !*stopCalled {
+		t.Errorf("expected the instance to be asked to stop")
+	}
+}
+
+func TestShutdownInstanceTimeout(t *testing.T) {
+	client, server, stopCalled := fakeInstanceServer(t, false)
+	defer server.Close()
+
+	// the instance never stops, so the caller falls back to a forced delete
+	if err := shutdownInstance(context.Background(), &utils.CombinedConfig{Client: client}, client, "12345", 100*time.Millisecond); err == nil {
+		t.Errorf("expected an error when the instance doesn't shut down in time")
+	}
+
+	if !*stopCalled {
+		t.Errorf("expected the instance to be asked to stop")
+	}
+}
+
+func TestGracefulShutdownTimeout(t *testing.T) {
+	if got := gracefulShutdownTimeout(4 * time.Minute); got != 2*time.Minute {
+		t.Errorf("expected half of the delete timeout, got %s", got)
+	}
+
+	if got := gracefulShutdownTimeout(30 * time.Minute); got != maxGracefulShutdownTimeout {
+		t.Errorf("expected the shutdown wait to be capped at %s, got %s", maxGracefulShutdownTimeout, got)
+	}
+}
+
+func TestShutdownInstanceLogsTransitions(t *testing.T) {
+	client, server, _ := fakeInstanceServer(t, true)
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	config := &utils.CombinedConfig{Client: client, LogStateTransitions: true}
+	if err := shutdownInstance(ctx, config, client, "12345", time.Second); err != nil {
+		t.Fatalf("expected the instance to shut down, got %s", err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode the logs: %s", err)
+	}
+	if len(entries) == 0 || entries[0]["@message"] != `instance 12345 is "SHUTOFF" after 0s` {
+		t.Errorf("expected the state of the shutdown to be logged, got %v", entries)
+	}
+}
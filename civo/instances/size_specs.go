@@ -0,0 +1,94 @@
+package instances
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/civo/civogo"
+)
+
+// instanceSizes caches the sizes listed for each API endpoint and region, they don't change
+// during an apply and would otherwise be listed again for every instance
+var instanceSizes = struct {
+	sync.Mutex
+	sizes map[string][]civogo.InstanceSize
+}{sizes: map[string][]civogo.InstanceSize{}}
+
+// cachedInstanceSizes returns the sizes available to the client, only asking the API the first time
+func cachedInstanceSizes(apiClient *civogo.Client) ([]civogo.InstanceSize, error) {
+	key := apiClient.BaseURL.String() + "|" + apiClient.Region
+
+	instanceSizes.Lock()
+	defer instanceSizes.Unlock()
+
+	if sizes, ok := instanceSizes.sizes[key]; ok {
+		return sizes, nil
+	}
+
+	sizes, err := apiClient.ListInstanceSizes()
+	if err != nil {
+		return nil, err
+	}
+	instanceSizes.sizes[key] = sizes
+
+	return sizes, nil
+}
+
+// instanceSpecs returns the CPU cores, RAM and disk of the instance, the ones in the response
+// of the API take precedence and the missing ones are resolved from the size of the instance
+func instanceSpecs(apiClient *civogo.Client, instance *civogo.Instance) (cpuCores, ramMegabytes, diskGigabytes int) {
+	cpuCores, ramMegabytes, diskGigabytes = instance.CPUCores, instance.RAMMegabytes, instance.DiskGigabytes
+	if (cpuCores != 0 && ramMegabytes != 0 && diskGigabytes != 0) || instance.Size == "" {
+		return cpuCores, ramMegabytes, diskGigabytes
+	}
+
+	sizes, err := cachedInstanceSizes(apiClient)
+	if err != nil {
+		log.Printf("[WARN] unable to list the instance sizes to resolve the specs of %s: %s", instance.Size, err)
+		return cpuCores, ramMegabytes, diskGigabytes
+	}
+
+	for _, size := range sizes {
+		if size.Name != instance.Size {
+			continue
+		}
+		if cpuCores == 0 {
+			cpuCores = size.CPUCores
+		}
+		if ramMegabytes == 0 {
+			ramMegabytes = size.RAMMegabytes
+		}
+		if diskGigabytes == 0 {
+			diskGigabytes = size.DiskGigabytes
+		}
+		break
+	}
+
+	return cpuCores, ramMegabytes, diskGigabytes
+}
+
+// validateInstanceSize returns an error listing the sizes an instance can use in the region of the
+// client when the size isn't one of them, sizes can differ between regions
+func validateInstanceSize(apiClient *civogo.Client, size string) error {
+	sizes, err := cachedInstanceSizes(apiClient)
+	if err != nil {
+		return fmt.Errorf("[ERR] failed to list the sizes of the region %s: %s", apiClient.Region, err)
+	}
+
+	available := []string{}
+	for _, s := range sizes {
+		if !s.Selectable || !strings.EqualFold(s.Type, "instance") {
+			continue
+		}
+		if s.Name == size {
+			return nil
+		}
+		available = append(available, s.Name)
+	}
+	sort.Strings(available)
+
+	return fmt.Errorf("the size %s is not available for instances in the region %s, the available sizes are: %s", size, apiClient.Region, strings.Join(available, ", "))
+}
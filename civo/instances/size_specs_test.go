@@ -0,0 +1,113 @@
+package instances
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/civo/civogo"
+)
+
+func TestInstanceSpecs(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if req.URL.Path != "/v2/sizes" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		calls++
+		rw.Write([]byte(`[
+			{"name": "g3.small", "cpu_cores": 1, "ram_mb": 2048, "disk_gb": 25},
+			{"name": "g3.medium", "cpu_cores": 2, "ram_mb": 4096, "disk_gb": 50}
+		]`))
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	cases := []struct {
+		name     string
+		instance civogo.Instance
+		expected [3]int
+	}{
+		{"resolved from the size", civogo.Instance{Size: "g3.medium"}, [3]int{2, 4096, 50}},
+		{"response takes precedence", civogo.Instance{Size: "g3.medium", CPUCores: 4, RAMMegabytes: 8192, DiskGigabytes: 100}, [3]int{4, 8192, 100}},
+		{"partially resolved", civogo.Instance{Size: "g3.small", CPUCores: 1}, [3]int{1, 2048, 25}},
+		{"unknown size", civogo.Instance{Size: "g9.huge"}, [3]int{0, 0, 0}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cpuCores, ramMegabytes, diskGigabytes := instanceSpecs(client, &tc.instance)
+			if got := [3]int{cpuCores, ramMegabytes, diskGigabytes}; got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+
+	if calls != 1 {
+		t.Errorf("expected the sizes to be listed once, got %d calls", calls)
+	}
+}
+
+func TestValidateInstanceSize(t *testing.T) {
+	// the GPU size is only sold in FRA1
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v2/sizes" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		sizes := `{"name": "g3.small", "type": "Instance", "selectable": true},
+			{"name": "g3.medium", "type": "Instance", "selectable": true},
+			{"name": "g3.legacy", "type": "Instance", "selectable": false},
+			{"name": "g4s.kube.small", "type": "Kubernetes", "selectable": true}`
+		if req.URL.Query().Get("region") == "FRA1" {
+			sizes += `, {"name": "an1.gpu", "type": "Instance", "selectable": true}`
+		}
+		rw.Write([]byte(`[` + sizes + `]`))
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	lon1 := *client
+	lon1.Region = "LON1"
+	fra1 := *client
+	fra1.Region = "FRA1"
+
+	if err := validateInstanceSize(&lon1, "g3.medium"); err != nil {
+		t.Errorf("expected g3.medium to be available in LON1, got %s", err)
+	}
+
+	if err := validateInstanceSize(&fra1, "an1.gpu"); err != nil {
+		t.Errorf("expected an1.gpu to be available in FRA1, got %s", err)
+	}
+
+	err = validateInstanceSize(&lon1, "an1.gpu")
+	if err == nil {
+		t.Fatalf("expected an1.gpu to be unavailable in LON1")
+	}
+	if !strings.Contains(err.Error(), "the available sizes are: g3.medium, g3.small") {
+		t.Errorf("expected the selectable instance sizes of LON1 in the error, got %s", err)
+	}
+
+	for _, size := range []string{"g3.legacy", "g4s.kube.small"} {
+		if err := validateInstanceSize(&lon1, size); err == nil {
+			t.Errorf("expected %s to be unavailable for instances", size)
+		}
+	}
+}
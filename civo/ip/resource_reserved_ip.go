@@ -2,6 +2,7 @@ package ip
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -30,6 +31,11 @@ func ResourceReservedIP() *schema.Resource {
 				Computed:    true,
 				Description: "The region of the ip",
 			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uniform resource name (URN) of the reserved IP, in the form `civo:reserved-ip:<region>:<id>`",
+			},
 			// Computed resource
 			"ip": {
 				Type:        schema.TypeString,
@@ -49,12 +55,7 @@ func ResourceReservedIP() *schema.Resource {
 
 // function to create a new IP resource
 func resourceReservedIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] creating the new ip address %s", d.Get("name").(string))
 	newIP := &civogo.CreateIPRequest{
@@ -71,7 +72,7 @@ func resourceReservedIPCreate(ctx context.Context, d *schema.ResourceData, m int
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{"BUILDING"},
 		Target:  []string{"ACTIVE"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("reserved IP %s", d.Id()), func() (interface{}, string, error) {
 			resp, err := apiClient.FindIP(d.Id())
 			if err != nil {
 				return 0, "", err
@@ -80,10 +81,11 @@ func resourceReservedIPCreate(ctx context.Context, d *schema.ResourceData, m int
 				return 0, "BUILDING", nil
 			}
 			return resp, "ACTIVE", nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 10,
 	}
 	_, err = createStateConf.WaitForStateContext(context.Background())
@@ -96,12 +98,7 @@ func resourceReservedIPCreate(ctx context.Context, d *schema.ResourceData, m int
 
 // function to read a the IP resource
 func resourceReservedIPRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] retriving the ip address %s", d.Id())
 	resp, err := apiClient.FindIP(d.Id())
@@ -116,6 +113,7 @@ func resourceReservedIPRead(_ context.Context, d *schema.ResourceData, m interfa
 
 	d.Set("name", resp.Name)
 	d.Set("region", apiClient.Region)
+	d.Set("urn", utils.URN("reserved-ip", apiClient.Region, d.Id()))
 	d.Set("ip", resp.IP)
 
 	return nil
@@ -123,12 +121,7 @@ func resourceReservedIPRead(_ context.Context, d *schema.ResourceData, m interfa
 
 // function to update the IP resource
 func resourceReservedIPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	if d.HasChange("name") {
 		log.Printf("[INFO] updating the iop name %s", d.Id())
@@ -146,12 +139,7 @@ func resourceReservedIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 // function to delete a network
 func resourceReservedIPDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] deleting the ip resource %s", d.Id())
 	_, err := apiClient.DeleteIP(d.Id())
@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -92,7 +93,7 @@ func CivoReservedIPUpdated(ip *civogo.IP, name string) resource.TestCheckFunc {
 }
 
 func CivoReservedIPDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_reserved_ip" {
@@ -0,0 +1,98 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// clusterResources returns the load balancers and volumes which were created for the cluster by its
+// controllers (e.g. a Service of type LoadBalancer or a PersistentVolumeClaim)
+func clusterResources(apiClient *civogo.Client, clusterID string) ([]civogo.LoadBalancer, []civogo.Volume, error) {
+	allLoadBalancers, err := apiClient.ListLoadBalancers()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list the load balancers: %s", err)
+	}
+
+	loadBalancers := []civogo.LoadBalancer{}
+	for _, lb := range allLoadBalancers {
+		if lb.ClusterID == clusterID {
+			loadBalancers = append(loadBalancers, lb)
+		}
+	}
+
+	allVolumes, err := apiClient.ListVolumes()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list the volumes: %s", err)
+	}
+
+	volumes := []civogo.Volume{}
+	for _, volume := range allVolumes {
+		if volume.ClusterID == clusterID {
+			volumes = append(volumes, volume)
+		}
+	}
+
+	return loadBalancers, volumes, nil
+}
+
+// deleteClusterWithResources deletes the load balancers of the cluster, the cluster itself and, once the
+// cluster is gone and its volumes are detached from the nodes, the volumes of the cluster
+func deleteClusterWithResources(ctx context.Context, config *utils.CombinedConfig, apiClient *civogo.Client, clusterID string, timeout time.Duration) error {
+	loadBalancers, volumes, err := clusterResources(apiClient, clusterID)
+	if err != nil {
+		return err
+	}
+
+	for _, lb := range loadBalancers {
+		log.Printf("[INFO] deleting the load balancer %s (%s) of the kubernetes cluster %s", lb.Name, lb.ID, clusterID)
+		if _, err := apiClient.DeleteLoadBalancer(lb.ID); err != nil {
+			return fmt.Errorf("failed to delete the load balancer %s: %s", lb.ID, err)
+		}
+	}
+
+	log.Printf("[INFO] deleting the kubernetes cluster %s", clusterID)
+	if _, err := apiClient.DeleteKubernetesCluster(clusterID); err != nil {
+		return fmt.Errorf("failed to delete the kubernetes cluster: %s", err)
+	}
+
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	deleteStateConf := &retry.StateChangeConf{
+		Pending: []string{"deleting"},
+		Target:  []string{"deleted"},
+		Refresh: config.JitterPolls(ctx, config.LogTransitions(ctx, fmt.Sprintf("Kubernetes cluster %s", clusterID), func() (interface{}, string, error) {
+			resp, err := apiClient.GetKubernetesCluster(clusterID)
+			if err != nil {
+				if errors.Is(err, civogo.DatabaseKubernetesClusterNotFoundError) {
+					return clusterID, "deleted", nil
+				}
+				return nil, "", err
+			}
+			return resp, "deleting", nil
+		})),
+		Timeout:      timeout,
+		MinTimeout:   config.PollDelay(3 * time.Second),
+		PollInterval: config.PollInterval(),
+	}
+	if _, err := deleteStateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the kubernetes cluster to be deleted before deleting its volumes: %s", err)
+	}
+
+	for _, volume := range volumes {
+		log.Printf("[INFO] deleting the volume %s (%s) of the kubernetes cluster %s", volume.Name, volume.ID, clusterID)
+		if _, err := apiClient.DeleteVolume(volume.ID); err != nil {
+			return fmt.Errorf("failed to delete the volume %s: %s", volume.ID, err)
+		}
+	}
+
+	return nil
+}
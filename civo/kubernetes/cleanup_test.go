@@ -0,0 +1,74 @@
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+)
+
+func TestDeleteClusterWithResources(t *testing.T) {
+	var mu sync.Mutex
+	clusterDeleted := false
+	deleted := []string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/loadbalancers":
+			rw.Write([]byte(`[
+				{"id": "lb-cluster", "name": "cluster-lb", "cluster_id": "cluster-id"},
+				{"id": "lb-other", "name": "other-lb", "cluster_id": "other-cluster-id"}
+			]`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/volumes":
+			rw.Write([]byte(`[
+				{"id": "volume-cluster", "name": "pvc-1", "cluster_id": "cluster-id"},
+				{"id": "volume-instance", "name": "data", "instance_id": "instance-id"}
+			]`))
+		case req.Method == http.MethodGet && req.URL.Path == "/v2/kubernetes/clusters/cluster-id":
+			if clusterDeleted {
+				rw.WriteHeader(http.StatusNotFound)
+				rw.Write([]byte(`{"code": "database_kubernetes_cluster_not_found", "reason": "The requested cluster could not be found"}`))
+				return
+			}
+			rw.Write([]byte(`{"id": "cluster-id", "name": "cluster", "status": "DELETING"}`))
+		case req.Method == http.MethodDelete:
+			if req.URL.Path == "/v2/volumes/volume-cluster" && !clusterDeleted {
+				t.Errorf("expected the volume to be deleted after the cluster")
+			}
+			if req.URL.Path == "/v2/kubernetes/clusters/cluster-id" {
+				clusterDeleted = true
+			}
+			deleted = append(deleted, req.URL.Path)
+			rw.Write([]byte(`{"result": "success"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	if err := deleteClusterWithResources(context.Background(), &utils.CombinedConfig{Client: client}, client, "cluster-id", time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// only the load balancers and volumes of the cluster are removed
+	expected := []string{"/v2/kubernetes/clusters/cluster-id", "/v2/loadbalancers/lb-cluster", "/v2/volumes/volume-cluster"}
+	sort.Strings(deleted)
+	if !reflect.DeepEqual(deleted, expected) {
+		t.Errorf("expected %v to be deleted, got %v", expected, deleted)
+	}
+}
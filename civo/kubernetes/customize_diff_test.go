@@ -0,0 +1,62 @@
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCustomizeDiffKubernetesClusterUsesResourceRegion(t *testing.T) {
+	var mu sync.Mutex
+	regions := map[string]string{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		regions[req.URL.Path] = req.URL.Query().Get("region")
+		mu.Unlock()
+
+		switch req.URL.Path {
+		case "/v2/sizes":
+			rw.Write([]byte(`[{"name": "g4s.kube.small", "type": "kubernetes", "selectable": true, "cpu_cores": 1, "ram_mb": 2048, "disk_gb": 40}]`))
+		case "/v2/quota":
+			rw.Write([]byte(`{"instance_count_limit": 10, "cpu_core_limit": 20, "ram_mb_limit": 65536, "disk_gb_limit": 1000}`))
+		case "/v2/regions":
+			rw.Write([]byte(`[{"code": "NYC1", "out_of_capacity": false}]`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+	// another resource left the shared client pointing to LON1
+	client.Region = "LON1"
+	config := &utils.CombinedConfig{Client: client, Region: "FRA1"}
+
+	_, err = ResourceKubernetesCluster().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":        "cluster",
+		"region":      "NYC1",
+		"firewall_id": "d4d48f41-5a7e-4f9c-a1b8-6c1b4e3f4c2a",
+		"pools": []interface{}{
+			map[string]interface{}{"size": "g4s.kube.small", "node_count": 3},
+		},
+	}), config)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, path := range []string{"/v2/regions", "/v2/quota"} {
+		if got := regions[path]; got != "NYC1" {
+			t.Errorf("expected %s to be requested in the region of the cluster, got %q", path, got)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -154,12 +155,13 @@ func dataSourceApplicationSchema() *schema.Schema {
 }
 
 func dataSourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	config := m.(*utils.CombinedConfig)
 
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	region, err := utils.ResolveRegion(d.Get("region").(string), config)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	apiClient := utils.ClientForRegion(config, region)
 
 	var foundCluster *civogo.KubernetesCluster
 
@@ -193,7 +195,7 @@ func dataSourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData,
 	d.Set("api_endpoint", foundCluster.APIEndPoint)
 	d.Set("master_ip", foundCluster.MasterIP)
 	d.Set("dns_entry", foundCluster.DNSEntry)
-	d.Set("created_at", foundCluster.CreatedAt.UTC().String())
+	d.Set("created_at", utils.FormatTime(foundCluster.CreatedAt))
 	d.Set("region", apiClient.Region)
 
 	if err := d.Set("pools", flattenDataSourceNodePool(foundCluster)); err != nil {
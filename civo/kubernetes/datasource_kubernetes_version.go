@@ -5,6 +5,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,7 +24,7 @@ func DataSourceKubernetesVersion() *schema.Resource {
 }
 
 func getKubernetesVersions(m interface{}, _ map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), "")
 
 	versions := []interface{}{}
 	partialVersions, err := apiClient.ListAvailableKubernetesVersions()
@@ -0,0 +1,77 @@
+package kubernetes
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	kubeconfigClientCertificate = regexp.MustCompile(`(?m)^\s*client-certificate-data:\s*"?([A-Za-z0-9+/=]+)"?\s*$`)
+	kubeconfigToken             = regexp.MustCompile(`(?m)^\s*token:\s*"?([^"\s]+)"?\s*$`)
+)
+
+// kubeconfigExpiry returns when the credentials embedded in the kubeconfig expire, it looks at
+// the client certificate first and then at the token if it is a JWT. The zero time is returned
+// when the kubeconfig has no expiring credentials or they can't be decoded
+func kubeconfigExpiry(kubeconfig string) time.Time {
+	if match := kubeconfigClientCertificate.FindStringSubmatch(kubeconfig); match != nil {
+		if expiry, ok := certificateExpiry(match[1]); ok {
+			return expiry
+		}
+	}
+
+	if match := kubeconfigToken.FindStringSubmatch(kubeconfig); match != nil {
+		if expiry, ok := tokenExpiry(match[1]); ok {
+			return expiry
+		}
+	}
+
+	return time.Time{}
+}
+
+// certificateExpiry decodes a base64 encoded PEM certificate and returns its NotAfter date
+func certificateExpiry(data string) (time.Time, bool) {
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return time.Time{}, false
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return cert.NotAfter, true
+}
+
+// tokenExpiry returns the exp claim of a JWT, static tokens don't expire
+func tokenExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(claims.Exp, 0), true
+}
@@ -0,0 +1,97 @@
+package kubernetes
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+)
+
+const kubeconfigTemplate = `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: dGVzdA==
+    server: https://74.220.20.1:6443
+  name: test
+contexts:
+- context:
+    cluster: test
+    user: test
+  name: test
+current-context: test
+kind: Config
+preferences: {}
+users:
+- name: test
+  user:
+%s
+`
+
+func testCertificate(t *testing.T, notAfter time.Time) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "system:admin"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestKubeconfigExpiry(t *testing.T) {
+	notAfter := time.Date(2030, time.January, 2, 3, 4, 5, 0, time.UTC)
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"admin","exp":%d}`, notAfter.Unix())))
+
+	cases := []struct {
+		name     string
+		user     string
+		expected time.Time
+	}{
+		{
+			name:     "client certificate",
+			user:     fmt.Sprintf("    client-certificate-data: %s\n    client-key-data: dGVzdA==", testCertificate(t, notAfter)),
+			expected: notAfter,
+		},
+		{
+			name:     "expiring token",
+			user:     fmt.Sprintf("    token: eyJhbGciOiJIUzI1NiJ9.%s.c2lnbmF0dXJl", payload),
+			expected: notAfter,
+		},
+		{
+			name: "static token",
+			user: "    token: 4c1f8e0f9a0b4d7e",
+		},
+		{
+			name: "invalid certificate",
+			user: "    client-certificate-data: dGVzdA==",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := kubeconfigExpiry(fmt.Sprintf(kubeconfigTemplate, c.user))
+			if !got.Equal(c.expected) {
+				t.Errorf("expected %s, got %s", c.expected, got)
+			}
+		})
+	}
+}
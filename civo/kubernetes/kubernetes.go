@@ -84,6 +84,13 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			Description:  "The ID of your cluster",
 			ValidateFunc: validation.StringIsNotEmpty,
 		}
+		s["region"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The region of the cluster, if not declared we use the region declared in the provider",
+		}
 	}
 
 	return s
@@ -0,0 +1,36 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/civo/civogo"
+)
+
+func TestFlattenInstalledApplication(t *testing.T) {
+	fixture := `[
+		{"application": "traefik2-nodeport", "name": "traefik2-nodeport", "version": "2.9.4", "installed": true, "category": "architecture"},
+		{"application": "metrics-server", "name": "metrics-server", "version": "0.6.1", "installed": true, "category": "architecture"},
+		{"application": "longhorn", "name": "longhorn", "version": "1.4.0", "installed": false, "category": "storage"}
+	]`
+
+	var apps []civogo.KubernetesInstalledApplication
+	if err := json.Unmarshal([]byte(fixture), &apps); err != nil {
+		t.Fatalf("failed to decode the fixture: %s", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"application": "traefik2-nodeport", "version": "2.9.4", "installed": true, "category": "architecture"},
+		map[string]interface{}{"application": "metrics-server", "version": "0.6.1", "installed": true, "category": "architecture"},
+		map[string]interface{}{"application": "longhorn", "version": "1.4.0", "installed": false, "category": "storage"},
+	}
+
+	if got := flattenInstalledApplication(apps); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if got := flattenInstalledApplication(nil); got != nil {
+		t.Errorf("expected nil for a cluster without applications, got %v", got)
+	}
+}
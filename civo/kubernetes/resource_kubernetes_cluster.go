@@ -34,6 +34,11 @@ func ResourceKubernetesCluster() *schema.Resource {
 				Computed:    true,
 				Description: "The region for the cluster, if not declare we use the region in declared in the provider",
 			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uniform resource name (URN) of the cluster, in the form `civo:kubernetes:<region>:<id>`",
+			},
 			"network_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -70,9 +75,10 @@ func ResourceKubernetesCluster() *schema.Resource {
 				ValidateFunc: utils.ValidateCNIName,
 			},
 			"tags": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Space separated list of tags, to be used freely as required",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: utils.ValidateSpaceSeparatedTags,
+				Description:  fmt.Sprintf("Space separated list of tags, to be used freely as required (at most %d tags of up to %d characters each)", utils.MaxTags, utils.MaxTagLength),
 			},
 			"applications": {
 				Type:     schema.TypeString,
@@ -133,6 +139,17 @@ func ResourceKubernetesCluster() *schema.Resource {
 				Description:      "Whether to write the kubeconfig to state",
 				ValidateDiagFunc: utils.ValidateProviderVersion,
 			},
+			"cleanup_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to delete the load balancers and volumes created by the cluster (e.g. for Services of type LoadBalancer and PersistentVolumeClaims) when the cluster is destroyed, the volumes are deleted once the cluster is gone (default: false)",
+			},
+			"kubeconfig_expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the credentials in the kubeconfig expire, in RFC3339 format. Empty if they don't expire",
+			},
 			"api_endpoint": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -204,12 +221,7 @@ func applicationSchema() *schema.Schema {
 
 // function to create a new cluster
 func resourceKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] configuring a new kubernetes cluster %s", d.Get("name").(string))
 
@@ -291,16 +303,17 @@ func resourceKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{"BUILDING", "AVAILABLE", "UPGRADING", "SCALING"},
 		Target:  []string{"ACTIVE"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("Kubernetes cluster %s", d.Id()), func() (interface{}, string, error) {
 			resp, err := apiClient.GetKubernetesCluster(d.Id())
 			if err != nil {
 				return 0, "", err
 			}
 			return resp, resp.Status, nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 10,
 	}
 	_, err = createStateConf.WaitForStateContext(context.Background())
@@ -313,12 +326,7 @@ func resourceKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData
 
 // function to read the kubernetes cluster
 func resourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] retrieving the kubernetes cluster %s", d.Id())
 	resp, err := apiClient.GetKubernetesCluster(d.Id())
@@ -332,6 +340,7 @@ func resourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData, m
 
 	d.Set("name", resp.Name)
 	d.Set("region", apiClient.Region)
+	d.Set("urn", utils.URN("kubernetes", apiClient.Region, d.Id()))
 	d.Set("network_id", resp.NetworkID)
 	d.Set("num_target_nodes", resp.NumTargetNode)
 	d.Set("target_nodes_size", resp.TargetNodeSize)
@@ -346,9 +355,17 @@ func resourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData, m
 	d.Set("master_ip", resp.MasterIP)
 	d.Set("dns_entry", resp.DNSEntry)
 	// d.Set("built_at", resp.BuiltAt.UTC().String())
-	d.Set("created_at", resp.CreatedAt.UTC().String())
+	d.Set("created_at", utils.FormatTime(resp.CreatedAt))
 	d.Set("firewall_id", resp.FirewallID)
 
+	// cleanup_on_destroy is missing from the state of the clusters imported or created before it was
+	// added, set its default so upgrading the provider plans no change
+	if _, ok := d.GetOkExists("cleanup_on_destroy"); !ok {
+		d.Set("cleanup_on_destroy", false)
+	}
+
+	d.Set("kubeconfig_expires_at", utils.FormatTime(kubeconfigExpiry(resp.KubeConfig)))
+
 	writeKubeconfig := d.Get("write_kubeconfig").(bool)
 	if writeKubeconfig {
 		d.Set("kubeconfig", resp.KubeConfig)
@@ -369,12 +386,7 @@ func resourceKubernetesClusterRead(_ context.Context, d *schema.ResourceData, m
 
 // function to update the kubernetes cluster
 func resourceKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	config := &civogo.KubernetesClusterConfig{}
 
@@ -460,12 +472,15 @@ func resourceKubernetesClusterUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 // function to delete the kubernetes cluster
-func resourceKubernetesClusterDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+func resourceKubernetesClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	if d.Get("cleanup_on_destroy").(bool) {
+		err := deleteClusterWithResources(ctx, m.(*utils.CombinedConfig), apiClient, d.Id(), d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.Errorf("[ERR] an error occurred while trying to delete the kubernetes cluster and its resources: %s", err)
+		}
+		return nil
 	}
 
 	log.Printf("[INFO] deleting the kubernetes cluster %s", d.Id())
@@ -488,6 +503,46 @@ func customizeDiffKubernetesCluster(ctx context.Context, d *schema.ResourceDiff,
 		if d.HasChange("cni") {
 			return fmt.Errorf("the 'cni' field is immutable")
 		}
+		return nil
+	}
+
+	config, ok := meta.(*utils.CombinedConfig)
+	if !ok {
+		return nil
+	}
+
+	// the capacity and the sizes are checked in the region of the cluster, the shared client may
+	// point to another region
+	apiClient := utils.ClientForRegion(config, d.Get("region").(string))
+
+	// check at plan time that the region of the new cluster isn't out of capacity
+	if !config.SkipCapacityCheck {
+		if err := utils.ValidateRegionCapacity(apiClient, apiClient.Region); err != nil {
+			return fmt.Errorf("the cluster %s can not be created, %s", d.Get("name").(string), err)
+		}
 	}
+
+	// check at plan time that all the nodes of the new cluster fit in the account quota
+	if config.SkipQuotaCheck {
+		return nil
+	}
+
+	sizes := map[string]int{}
+	for _, pool := range d.Get("pools").([]interface{}) {
+		poolMap, ok := pool.(map[string]interface{})
+		if !ok || poolMap["size"].(string) == "" {
+			continue
+		}
+		sizes[poolMap["size"].(string)] += poolMap["node_count"].(int)
+	}
+
+	if len(sizes) == 0 {
+		return nil
+	}
+
+	if err := utils.ValidateQuota(apiClient, sizes); err != nil {
+		return fmt.Errorf("the cluster %s can not be created, %s", d.Get("name").(string), err)
+	}
+
 	return nil
 }
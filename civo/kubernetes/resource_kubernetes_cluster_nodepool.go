@@ -41,12 +41,7 @@ func ResourceKubernetesClusterNodePool() *schema.Resource {
 
 // function to create a new cluster
 func resourceKubernetesClusterNodePoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	clusterID := d.Get("cluster_id").(string)
 
@@ -127,7 +122,7 @@ func resourceKubernetesClusterNodePoolCreate(ctx context.Context, d *schema.Reso
 
 // function to read the kubernetes cluster
 func resourceKubernetesClusterNodePoolRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 	clusterID := d.Get("cluster_id").(string)
 
 	// Warning or errors can be collected in a slice type
@@ -155,6 +150,7 @@ func resourceKubernetesClusterNodePoolRead(_ context.Context, d *schema.Resource
 
 	d.SetId(respPool.ID)
 	d.Set("cluster_id", resp.ID)
+	d.Set("region", apiClient.Region)
 	d.Set("node_count", respPool.Count)
 	d.Set("size", respPool.Size)
 
@@ -188,12 +184,7 @@ func resourceKubernetesClusterNodePoolRead(_ context.Context, d *schema.Resource
 
 // function to update the kubernetes cluster
 func resourceKubernetesClusterNodePoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	old, new := d.GetChange("size")
 	if old != new {
@@ -262,7 +253,7 @@ func resourceKubernetesClusterNodePoolUpdate(ctx context.Context, d *schema.Reso
 
 // function to delete the kubernetes cluster
 func resourceKubernetesClusterNodePoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	clusterID := d.Get("cluster_id").(string)
 	getKubernetesCluster, err := apiClient.GetKubernetesCluster(clusterID)
@@ -270,11 +261,6 @@ func resourceKubernetesClusterNodePoolDelete(ctx context.Context, d *schema.Reso
 		return diag.Errorf("[INFO] error getting kubernetes cluster: %s", clusterID)
 	}
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
-
 	log.Printf("[INFO] deleting the kubernetes cluster %s", d.Id())
 	_, err = apiClient.DeleteKubernetesClusterPool(getKubernetesCluster.ID, d.Id())
 	if err != nil {
@@ -304,8 +290,8 @@ func resourceKubernetesClusterNodePoolDelete(ctx context.Context, d *schema.Reso
 
 // custom import to able to add a node pool to the terraform
 func resourceKubernetesClusterNodePoolImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	apiClient := m.(*civogo.Client)
-	regions, err := apiClient.ListRegions()
+	config := m.(*utils.CombinedConfig)
+	regions, err := config.Client.ListRegions()
 	if err != nil {
 		return nil, err
 	}
@@ -322,7 +308,7 @@ func resourceKubernetesClusterNodePoolImport(d *schema.ResourceData, m interface
 		}
 
 		currentRegionCode := region.Code
-		apiClient.Region = currentRegionCode
+		apiClient := utils.ClientForRegion(config, currentRegionCode)
 
 		log.Printf("[INFO] Retriving the node pool %s from region %s", nodePoolID, currentRegionCode)
 		respPool, err := apiClient.GetKubernetesClusterPool(clusterID, nodePoolID)
@@ -0,0 +1,63 @@
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceKubernetesClusterNodePoolReadOtherRegion(t *testing.T) {
+	// the cluster only exists in NYC1
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("region") != "NYC1" {
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(`{"code": "database_cluster_not_found", "reason": "The requested cluster could not be found"}`))
+			return
+		}
+
+		switch req.URL.Path {
+		case "/v2/kubernetes/clusters/cluster-id":
+			rw.Write([]byte(`{"id": "cluster-id", "name": "cluster"}`))
+		case "/v2/kubernetes/clusters/cluster-id/pools/pool-id":
+			rw.Write([]byte(`{"id": "pool-id", "count": 3, "size": "g4s.kube.small", "instance_names": ["node-1", "node-2", "node-3"]}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+	// another resource left the shared client pointing to LON1
+	client.Region = "LON1"
+
+	d := schema.TestResourceDataRaw(t, ResourceKubernetesClusterNodePool().Schema, map[string]interface{}{
+		"cluster_id": "cluster-id",
+		"region":     "NYC1",
+	})
+	d.SetId("pool-id")
+
+	if diags := resourceKubernetesClusterNodePoolRead(context.Background(), d, &utils.CombinedConfig{Client: client, Region: "LON1"}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "pool-id" {
+		t.Fatalf("expected the pool to be kept in the state, got the ID %q", d.Id())
+	}
+	if got := d.Get("node_count").(int); got != 3 {
+		t.Errorf("expected 3 nodes, got %d", got)
+	}
+	if got := d.Get("region").(string); got != "NYC1" {
+		t.Errorf("expected the region NYC1, got %q", got)
+	}
+	if client.Region != "LON1" {
+		t.Errorf("expected the shared client to be left alone, got %q", client.Region)
+	}
+}
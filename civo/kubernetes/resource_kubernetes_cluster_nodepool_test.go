@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -73,7 +74,7 @@ func CivoKubernetesClusterNodePoolResourceExists(n string, kubernetes *civogo.Ku
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 		resp, err := client.GetKubernetesCluster(kubernetes.ID)
 		if err != nil {
 			return fmt.Errorf("Kuberenetes Cluster not found: (%s) %s", rs.Primary.ID, err)
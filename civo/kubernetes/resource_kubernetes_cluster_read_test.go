@@ -0,0 +1,42 @@
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceKubernetesClusterReadDefaultsMissingAttributes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v2/kubernetes/clusters/cluster-id" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rw.Write([]byte(`{"id": "cluster-id", "name": "cluster", "status": "ACTIVE"}`))
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	// the state of a cluster created before cleanup_on_destroy was added
+	d := ResourceKubernetesCluster().Data(&terraform.InstanceState{
+		ID:         "cluster-id",
+		Attributes: map[string]string{"id": "cluster-id", "name": "cluster"},
+	})
+
+	if diags := resourceKubernetesClusterRead(context.Background(), d, &utils.CombinedConfig{Client: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if _, ok := d.GetOkExists("cleanup_on_destroy"); !ok {
+		t.Errorf("expected cleanup_on_destroy to be set")
+	}
+}
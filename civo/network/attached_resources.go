@@ -0,0 +1,63 @@
+package network
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/civo/civogo"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// attachedResources returns the sorted IDs of the instances and of the Kubernetes clusters in the
+// network, the API doesn't link networks to their resources so every one of the region is scanned
+func attachedResources(apiClient *civogo.Client, networkID string) (instanceIDs, clusterIDs []string, err error) {
+	instances, err := apiClient.ListAllInstances()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list the instances: %s", err)
+	}
+
+	instanceIDs = []string{}
+	for _, instance := range instances {
+		if instance.NetworkID == networkID {
+			instanceIDs = append(instanceIDs, instance.ID)
+		}
+	}
+	sort.Strings(instanceIDs)
+
+	clusters, err := apiClient.ListKubernetesClusters()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list the Kubernetes clusters: %s", err)
+	}
+
+	clusterIDs = []string{}
+	for _, cluster := range clusters.Items {
+		if cluster.NetworkID == networkID {
+			clusterIDs = append(clusterIDs, cluster.ID)
+		}
+	}
+	sort.Strings(clusterIDs)
+
+	return instanceIDs, clusterIDs, nil
+}
+
+// setAttachedResources sets the resources in the network on the network resource or data source.
+// The scan depends on the instance and Kubernetes APIs, so when it fails the network is still read,
+// the attached resources keep their previous values and a warning is returned
+func setAttachedResources(d *schema.ResourceData, apiClient *civogo.Client, networkID string) diag.Diagnostics {
+	instanceIDs, clusterIDs, err := attachedResources(apiClient, networkID)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unable to list the resources in the network",
+			Detail:   fmt.Sprintf("The attached instances and clusters of the network %s weren't refreshed: %s", networkID, err),
+		}}
+	}
+
+	d.Set("attached_instances", instanceIDs)
+	d.Set("instance_count", len(instanceIDs))
+	d.Set("attached_clusters", clusterIDs)
+	d.Set("cluster_count", len(clusterIDs))
+
+	return nil
+}
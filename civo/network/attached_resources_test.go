@@ -0,0 +1,140 @@
+package network
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func fakeNetworkServer(t *testing.T, instancesJSON, clustersJSON string) (*civogo.Client, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v2/networks":
+			rw.Write([]byte(`[{"id": "net-1", "name": "cust-default", "label": "default", "default": true}]`))
+		case "/v2/instances":
+			rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": ` + instancesJSON + `}`))
+		case "/v2/kubernetes/clusters":
+			rw.Write([]byte(`{"page": 1, "per_page": 20, "pages": 1, "items": ` + clustersJSON + `}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	return client, server
+}
+
+func TestResourceNetworkReadAttachedResources(t *testing.T) {
+	client, server := fakeNetworkServer(t, `[
+		{"id": "web-2", "hostname": "web-2", "network_id": "net-1"},
+		{"id": "db-1", "hostname": "db-1", "network_id": "net-2"},
+		{"id": "web-1", "hostname": "web-1", "network_id": "net-1"}
+	]`, `[
+		{"id": "k8s-1", "name": "prod", "network_id": "net-1"},
+		{"id": "k8s-2", "name": "staging", "network_id": "net-2"}
+	]`)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceNetwork().Schema, map[string]interface{}{"label": "default"})
+	d.SetId("net-1")
+
+	if diags := resourceNetworkRead(context.Background(), d, &utils.CombinedConfig{Client: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("attached_instances").([]interface{}); !reflect.DeepEqual(got, []interface{}{"web-1", "web-2"}) {
+		t.Errorf("expected the instances in the network sorted by ID, got %v", got)
+	}
+	if got := d.Get("instance_count").(int); got != 2 {
+		t.Errorf("expected 2 instances, got %d", got)
+	}
+	if got := d.Get("attached_clusters").([]interface{}); !reflect.DeepEqual(got, []interface{}{"k8s-1"}) {
+		t.Errorf("expected the cluster in the network, got %v", got)
+	}
+	if got := d.Get("cluster_count").(int); got != 1 {
+		t.Errorf("expected 1 cluster, got %d", got)
+	}
+}
+
+func TestResourceNetworkReadNothingAttached(t *testing.T) {
+	client, server := fakeNetworkServer(t, `[]`, `null`)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceNetwork().Schema, map[string]interface{}{"label": "default"})
+	d.SetId("net-1")
+
+	if diags := resourceNetworkRead(context.Background(), d, &utils.CombinedConfig{Client: client}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("attached_instances").([]interface{}); len(got) != 0 {
+		t.Errorf("expected no instances, got %v", got)
+	}
+	if got := d.Get("attached_clusters").([]interface{}); len(got) != 0 {
+		t.Errorf("expected no clusters, got %v", got)
+	}
+	if got := d.Get("instance_count").(int) + d.Get("cluster_count").(int); got != 0 {
+		t.Errorf("expected nothing to be counted, got %d", got)
+	}
+}
+
+func TestResourceNetworkReadAttachedResourcesUnavailable(t *testing.T) {
+	// the instance API fails, the network itself can still be read
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v2/networks":
+			rw.Write([]byte(`[{"id": "net-1", "name": "cust-default", "label": "renamed", "default": true}]`))
+		default:
+			rw.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	d := ResourceNetwork().Data(&terraform.InstanceState{
+		ID: "net-1",
+		Attributes: map[string]string{
+			"id":                   "net-1",
+			"label":                "default",
+			"attached_instances.#": "1",
+			"attached_instances.0": "web-1",
+			"instance_count":       "1",
+		},
+	})
+
+	diags := resourceNetworkRead(context.Background(), d, &utils.CombinedConfig{Client: client})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a warning, got %v", diags)
+	}
+
+	if got := d.Get("label").(string); got != "renamed" {
+		t.Errorf("expected the network to be read, got the label %q", got)
+	}
+	if got := d.Get("attached_instances").([]interface{}); !reflect.DeepEqual(got, []interface{}{"web-1"}) {
+		t.Errorf("expected the previous instances to be kept, got %v", got)
+	}
+	if got := d.Get("instance_count").(int); got != 1 {
+		t.Errorf("expected the previous count to be kept, got %d", got)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -53,17 +54,40 @@ func DataSourceNetwork() *schema.Resource {
 				Computed:    true,
 				Description: "If is the default network",
 			},
+			"attached_instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the instances in the network",
+			},
+			"instance_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of instances in the network",
+			},
+			"attached_clusters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the Kubernetes clusters in the network",
+			},
+			"cluster_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of Kubernetes clusters in the network",
+			},
 		},
 	}
 }
 
 func dataSourceNetworkRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	config := m.(*utils.CombinedConfig)
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	region, err := utils.ResolveRegion(d.Get("region").(string), config)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	apiClient := utils.ClientForRegion(config, region)
 
 	var foundNetwork *civogo.Network
 
@@ -91,5 +115,5 @@ func dataSourceNetworkRead(_ context.Context, d *schema.ResourceData, m interfac
 	d.Set("region", apiClient.Region)
 	d.Set("default", foundNetwork.Default)
 
-	return nil
+	return setAttachedResources(d, apiClient, foundNetwork.ID)
 }
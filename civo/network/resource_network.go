@@ -32,6 +32,11 @@ func ResourceNetwork() *schema.Resource {
 				Computed:    true,
 				Description: "The region of the network",
 			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uniform resource name (URN) of the network, in the form `civo:network:<region>:<id>`",
+			},
 			"cidr_v4": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -58,6 +63,28 @@ func ResourceNetwork() *schema.Resource {
 				Computed:    true,
 				Description: "If the network is default, this will be `true`",
 			},
+			"attached_instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the instances in the network",
+			},
+			"instance_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of instances in the network",
+			},
+			"attached_clusters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the Kubernetes clusters in the network",
+			},
+			"cluster_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of Kubernetes clusters in the network",
+			},
 			// VLAN Network
 			"vlan_id": {
 				Type:        schema.TypeInt,
@@ -108,12 +135,7 @@ func ResourceNetwork() *schema.Resource {
 
 // function to create a new network
 func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] creating the new network %s", d.Get("label").(string))
 	vlanConfig := civogo.VLANConnectConfig{
@@ -158,12 +180,7 @@ func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 // function to read a network
 func resourceNetworkRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	CurrentNetwork := civogo.Network{}
 
@@ -186,22 +203,18 @@ func resourceNetworkRead(_ context.Context, d *schema.ResourceData, m interface{
 
 	d.Set("name", CurrentNetwork.Name)
 	d.Set("region", apiClient.Region)
+	d.Set("urn", utils.URN("network", apiClient.Region, d.Id()))
 	d.Set("label", CurrentNetwork.Label)
 	d.Set("default", CurrentNetwork.Default)
 	d.Set("cidr_v4", CurrentNetwork.CIDR)
 	d.Set("nameservers_v4", CurrentNetwork.NameserversV4)
 
-	return nil
+	return setAttachedResources(d, apiClient, d.Id())
 }
 
 // function to update the network
 func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	if d.HasChange("label") {
 		log.Printf("[INFO] updating the network %s", d.Id())
@@ -227,13 +240,8 @@ func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 // function to delete a network
-func resourceNetworkDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	netowrkID := d.Id()
 	log.Printf("[INFO] Checking if firewall %s exists", netowrkID)
@@ -248,16 +256,17 @@ func resourceNetworkDelete(_ context.Context, d *schema.ResourceData, m interfac
 	deleteStateConf := &resource.StateChangeConf{
 		Pending: []string{"failed"},
 		Target:  []string{"success"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("network %s", netowrkID), func() (interface{}, string, error) {
 			resp, err := apiClient.DeleteNetwork(netowrkID)
 			if err != nil {
 				return 0, "", err
 			}
 			return resp, string(resp.Result), nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 10,
 	}
 	_, err = deleteStateConf.WaitForStateContext(context.Background())
@@ -0,0 +1,40 @@
+package objectstorage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCivoObjectStore_importBasic(t *testing.T) {
+	resourceName := "civo_object_store.foobar"
+	storeName := acctest.RandomWithPrefix("tf-test-import")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: CivoObjectStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoObjectStoreConfigBasic(storeName),
+			},
+			{
+				// the store lives in the region of the config, not the one of the provider
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["region"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
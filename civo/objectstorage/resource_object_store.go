@@ -2,7 +2,9 @@ package objectstorage
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/civo/civogo"
@@ -30,6 +32,11 @@ func ResourceObjectStore() *schema.Resource {
 				Computed:    true,
 				Description: "The region for the Object Store, if not declared we use the region as declared in the provider (Defaults to LON1)",
 			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uniform resource name (URN) of the Object Store, in the form `civo:object-store:<region>:<id>`",
+			},
 			"max_size_gb": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -42,6 +49,11 @@ func ResourceObjectStore() *schema.Resource {
 				Computed:    true,
 				Description: "The access key ID from the Object Store credential. If this is not set, a new credential will be created.",
 			},
+			"credential_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the Object Store credential associated with the Object Store, empty if there is none.",
+			},
 			"bucket_url": {
 				Type:        schema.TypeString,
 				Description: "The endpoint of the Object Store. It is generated by the provider.",
@@ -58,7 +70,7 @@ func ResourceObjectStore() *schema.Resource {
 		UpdateContext: resourceObjectStoreUpdate,
 		DeleteContext: resourceObjectStoreDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceObjectStoreImport,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -68,12 +80,7 @@ func ResourceObjectStore() *schema.Resource {
 
 // Function to create an Object Store
 func resourceObjectStoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] configuring the Object Store %s", d.Get("name").(string))
 	config := &civogo.CreateObjectStoreRequest{
@@ -97,16 +104,17 @@ func resourceObjectStoreCreate(ctx context.Context, d *schema.ResourceData, m in
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{"creating"},
 		Target:  []string{"ready"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("Object Store %s", d.Id()), func() (interface{}, string, error) {
 			resp, err := apiClient.GetObjectStore(d.Id())
 			if err != nil {
 				return 0, "", err
 			}
 			return resp, resp.Status, nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 60,
 	}
 	_, err = createStateConf.WaitForStateContext(ctx)
@@ -120,12 +128,7 @@ func resourceObjectStoreCreate(ctx context.Context, d *schema.ResourceData, m in
 
 // Function to read Object Store
 func resourceObjectStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] retriving the Object Store %s", d.Id())
 	resp, err := apiClient.GetObjectStore(d.Id())
@@ -141,7 +144,9 @@ func resourceObjectStoreRead(ctx context.Context, d *schema.ResourceData, m inte
 	d.Set("name", resp.Name)
 	d.Set("max_size_gb", resp.MaxSize)
 	d.Set("region", apiClient.Region)
+	d.Set("urn", utils.URN("object-store", apiClient.Region, d.Id()))
 	d.Set("access_key_id", resp.OwnerInfo.AccessKeyID)
+	d.Set("credential_id", resp.OwnerInfo.CredentialID)
 	d.Set("bucket_url", resp.BucketURL)
 	d.Set("status", resp.Status)
 
@@ -150,12 +155,7 @@ func resourceObjectStoreRead(ctx context.Context, d *schema.ResourceData, m inte
 
 // Function to update the Object Store
 func resourceObjectStoreUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	_, err := apiClient.FindObjectStore(d.Id())
 	if err != nil {
@@ -181,12 +181,7 @@ func resourceObjectStoreUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 // Function to delete an Object Store
 func resourceObjectStoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] deleting the Object Store %s", d.Id())
 	_, err := apiClient.DeleteObjectStore(d.Id())
@@ -195,3 +190,21 @@ func resourceObjectStoreDelete(ctx context.Context, d *schema.ResourceData, m in
 	}
 	return nil
 }
+
+// resourceObjectStoreImport imports an Object Store either by its ID, in which case the region
+// of the provider is used, or as region:id for an Object Store living in another region
+func resourceObjectStoreImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if !strings.Contains(d.Id(), ":") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	region, storeID, err := utils.ResourceCommonParseID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(storeID)
+	d.Set("region", region)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -2,6 +2,8 @@ package objectstorage
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"time"
 
@@ -34,12 +36,13 @@ func ResourceObjectStoreCredential() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "The access key id of the Object Store Credential. It is generated by the provider.",
+				Description: "The access key id of the Object Store Credential. It is generated by the provider if not set. If a credential with this access key already exists, it is adopted into the state instead of being created again.",
 			},
 			"secret_access_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "The secret access key of the Object Store Credential. It is generated by the provider.",
 			},
 			"status": {
@@ -63,12 +66,7 @@ func ResourceObjectStoreCredential() *schema.Resource {
 
 // Function to create an Object Store Credential
 func resourceObjectStoreCredentialCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] configuring the Object Store Credential %s", d.Get("name").(string))
 	config := &civogo.CreateObjectStoreCredentialRequest{
@@ -79,6 +77,17 @@ func resourceObjectStoreCredentialCreate(ctx context.Context, d *schema.Resource
 	if v, ok := d.GetOk("access_key_id"); ok {
 		AccessKeyID := v.(string)
 		config.AccessKeyID = &AccessKeyID
+
+		// adopt the credential if one with this access key already exists instead of creating it again
+		existing, err := findObjectStoreCredentialByAccessKey(apiClient, AccessKeyID)
+		if err != nil {
+			return diag.Errorf("[ERR] failed to look up the Object Store Credential: %s", err)
+		}
+		if existing != nil {
+			log.Printf("[INFO] adopting the existing Object Store Credential %s for the access key %s", existing.ID, AccessKeyID)
+			d.SetId(existing.ID)
+			return resourceObjectStoreCredentialRead(ctx, d, m)
+		}
 	}
 
 	if v, ok := d.GetOk("secret_access_key"); ok {
@@ -97,16 +106,17 @@ func resourceObjectStoreCredentialCreate(ctx context.Context, d *schema.Resource
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"ready"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("Object Store credential %s", d.Id()), func() (interface{}, string, error) {
 			resp, err := apiClient.GetObjectStoreCredential(d.Id())
 			if err != nil {
 				return 0, "", err
 			}
 			return resp, resp.Status, nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 60,
 	}
 	_, err = createStateConf.WaitForStateContext(ctx)
@@ -117,14 +127,36 @@ func resourceObjectStoreCredentialCreate(ctx context.Context, d *schema.Resource
 	return resourceObjectStoreCredentialRead(ctx, d, m)
 }
 
-// Function to read Object Store Credential
-func resourceObjectStoreCredentialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+// findObjectStoreCredentialByAccessKey returns the credential with exactly this access key, or nil if there is none.
+// ListObjectStoreCredentials of civogo only returns the first page, so the pages are fetched here
+func findObjectStoreCredentialByAccessKey(apiClient *civogo.Client, accessKeyID string) (*civogo.ObjectStoreCredential, error) {
+	for page := 1; ; page++ {
+		resp, err := apiClient.SendGetRequest(fmt.Sprintf("/v2/objectstore/credentials?page=%d", page))
+		if err != nil {
+			return nil, err
+		}
 
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+		creds := civogo.PaginatedObjectStoreCredentials{}
+		if err := json.Unmarshal(resp, &creds); err != nil {
+			return nil, err
+		}
+
+		for _, cred := range creds.Items {
+			if cred.AccessKeyID == accessKeyID {
+				return &cred, nil
+			}
+		}
+
+		// the requested page, not the one in the response, so a server ignoring the page can't loop forever
+		if page >= creds.Pages {
+			return nil, nil
+		}
 	}
+}
+
+// Function to read Object Store Credential
+func resourceObjectStoreCredentialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] retriving the Object Store Credential %s", d.Id())
 	resp, err := apiClient.GetObjectStoreCredential(d.Id())
@@ -146,12 +178,7 @@ func resourceObjectStoreCredentialRead(ctx context.Context, d *schema.ResourceDa
 
 // Function to update the Object Store Credential
 func resourceObjectStoreCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	_, err := apiClient.FindObjectStoreCredential(d.Id())
 	if err != nil {
@@ -183,12 +210,7 @@ func resourceObjectStoreCredentialUpdate(ctx context.Context, d *schema.Resource
 
 // Function to delete an Object Store Credential
 func resourceObjectStoreCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it is defined in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] deleting the Object Store Credential %s", d.Id())
 	_, err := apiClient.DeleteObjectStoreCredential(d.Id())
@@ -0,0 +1,65 @@
+package objectstorage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceObjectStoreCredentialCreateAdoptsExisting(t *testing.T) {
+	created := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.Method == http.MethodPost:
+			created = true
+			rw.WriteHeader(http.StatusInternalServerError)
+		// the existing credential is on the second page
+		case req.URL.Path == "/v2/objectstore/credentials" && req.URL.Query().Get("page") == "1":
+			rw.Write([]byte(`{"page": 1, "per_page": 1, "pages": 2, "items": [
+				{"id": "other-id", "name": "other", "access_key_id": "OTHERKEY", "status": "ready"}
+			]}`))
+		case req.URL.Path == "/v2/objectstore/credentials" && req.URL.Query().Get("page") == "2":
+			rw.Write([]byte(`{"page": 2, "per_page": 1, "pages": 2, "items": [
+				{"id": "cred-id", "name": "existing", "access_key_id": "MYACCESSKEY", "secret_access_key_id": "secret", "status": "ready"}
+			]}`))
+		case req.URL.Path == "/v2/objectstore/credentials/cred-id":
+			rw.Write([]byte(`{"id": "cred-id", "name": "existing", "access_key_id": "MYACCESSKEY", "secret_access_key_id": "secret", "status": "ready"}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourceObjectStoreCredential().Schema, map[string]interface{}{
+		"name":          "existing",
+		"access_key_id": "MYACCESSKEY",
+	})
+
+	diags := resourceObjectStoreCredentialCreate(context.Background(), d, &utils.CombinedConfig{Client: client})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if created {
+		t.Errorf("expected the existing credential to be adopted, but a new one was created")
+	}
+
+	if d.Id() != "cred-id" {
+		t.Errorf("expected the ID of the existing credential, got %q", d.Id())
+	}
+
+	if d.Get("secret_access_key").(string) != "secret" {
+		t.Errorf("expected the existing credential to be read into the state")
+	}
+}
@@ -0,0 +1,64 @@
+package objectstorage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceObjectStoreReadCredentialID(t *testing.T) {
+	cases := map[string]struct {
+		ownerInfo string
+		expected  string
+	}{
+		"with a credential": {
+			ownerInfo: `{"access_key_id": "MYACCESSKEY", "name": "backup-key", "credential_id": "cred-id"}`,
+			expected:  "cred-id",
+		},
+		"without a credential": {
+			ownerInfo: `{}`,
+			expected:  "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				if req.URL.Path != "/v2/objectstores/store-id" {
+					rw.WriteHeader(http.StatusNotFound)
+					return
+				}
+				rw.Write([]byte(`{"id": "store-id", "name": "backup", "max_size": 500, "owner_info": ` + tc.ownerInfo + `, "objectstore_endpoint": "objectstore.lon1.civo.com", "status": "ready"}`))
+			}))
+			defer server.Close()
+
+			client, err := civogo.NewClientForTestingWithServer(server)
+			if err != nil {
+				t.Fatalf("failed to create the client: %s", err)
+			}
+
+			d := schema.TestResourceDataRaw(t, ResourceObjectStore().Schema, map[string]interface{}{
+				"name": "backup",
+			})
+			d.SetId("store-id")
+
+			diags := resourceObjectStoreRead(context.Background(), d, &utils.CombinedConfig{Client: client})
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got := d.Get("credential_id").(string); got != tc.expected {
+				t.Errorf("expected credential_id %q, got %q", tc.expected, got)
+			}
+
+			if got := d.Get("urn").(string); got != "civo:object-store:test:store-id" {
+				t.Errorf("expected the URN civo:object-store:test:store-id, got %q", got)
+			}
+		})
+	}
+}
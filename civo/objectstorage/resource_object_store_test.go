@@ -7,6 +7,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -102,7 +103,7 @@ func CivoObjectStoreResourceExists(n string, store *civogo.ObjectStore) resource
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 		resp, err := client.FindObjectStore(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Object Store not found: (%s) %s", rs.Primary.ID, err)
@@ -125,7 +126,7 @@ func CivoObjectStoreUpdated(store *civogo.ObjectStore, name string) resource.Tes
 }
 
 func CivoObjectStoreDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_object_store" {
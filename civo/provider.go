@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/database"
@@ -22,10 +24,13 @@ import (
 	"github.com/civo/terraform-provider-civo/civo/region"
 	"github.com/civo/terraform-provider-civo/civo/size"
 	"github.com/civo/terraform-provider-civo/civo/ssh"
+	"github.com/civo/terraform-provider-civo/civo/tags"
 	"github.com/civo/terraform-provider-civo/civo/volume"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -38,7 +43,7 @@ var (
 
 // Provider Civo cloud provider
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"token": {
 				Type:             schema.TypeString,
@@ -66,6 +71,76 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("CIVO_API_URL", ProdAPI),
 				Description: "The Base URL to use for CIVO API.",
 			},
+			"region_api_urls": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validateRegionAPIURLs,
+				Description:      "A map of region codes to the Base URL of the CIVO API serving that region, for private or sovereign deployments. Resources and data sources in a listed region, whether it's the provider region or their own `region`, use its URL instead of `api_endpoint`.",
+			},
+			"skip_quota_check": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip the plan time check of the account quota done before creating instances and Kubernetes clusters.",
+			},
+			"log_state_transitions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Log every change of state seen while waiting on a resource, with the time elapsed since the wait started, so the progress of long waits shows up in the logs (`TF_LOG=INFO`).",
+			},
+			"skip_capacity_check": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip the plan time check that the region isn't out of capacity done before creating instances and Kubernetes clusters.",
+			},
+			"poll_jitter_percent": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(0, 50),
+				Description:  "How much, in percent, the interval between two checks of a resource waiting on the API is randomly spread, so the resources of a large apply don't all poll the API at the same time.",
+			},
+			"max_poll_interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 10),
+				Description:  "The longest interval, in seconds, between two checks of a resource waiting on the API, before the jitter of `poll_jitter_percent` is added. The interval grows up to 10 seconds by default, a lower value polls at a fixed interval instead.",
+			},
+			"max_concurrent_instances": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "How many instances are created at the same time, the others wait for their turn. Useful to stay below the provisioning limits of the account in large applies, 0 doesn't limit them.",
+			},
+			"tag_firewall_map": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A map of instance tags to firewall IDs. An instance which doesn't set `firewall_id` gets the firewall mapped to its tags, before falling back to `default_firewall_id`. Creating an instance whose tags map to different firewalls fails.",
+			},
+			"verbose_errors": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Add the last response of the Civo API received by the failed resource or data source, with its secrets redacted, to its errors, with its HTTP status when it's known. This covers the plan and the imports too. Useful for support requests, a response which isn't JSON is left out as it can't be redacted.",
+			},
+			"default_sshkey_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: utils.ValidateUUID,
+				Description:  "The ID of the SSH key used by the instances which don't set `sshkey_id`, the `sshkey_id` of an instance always takes precedence.",
+			},
+			"default_firewall_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: utils.ValidateUUID,
+				Description:  "The ID of the firewall used by the instances which don't set `firewall_id`, the `firewall_id` of an instance always takes precedence.",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			// "civo_template":           dataSourceTemplate(),
@@ -82,12 +157,16 @@ func Provider() *schema.Provider {
 			"civo_firewall":                firewall.DataSourceFirewall(),
 			"civo_loadbalancer":            loadbalancer.DataSourceLoadBalancer(),
 			"civo_ssh_key":                 ssh.DataSourceSSHKey(),
+			"civo_ssh_keys":                ssh.DataSourceSSHKeys(),
 			"civo_object_store":            objectstorage.DataSourceObjectStore(),
 			"civo_object_store_credential": objectstorage.DataSourceObjectStoreCredential(),
 			"civo_region":                  region.DataSourceRegion(),
 			"civo_reserved_ip":             ip.DataSourceReservedIP(),
 			"civo_database":                database.DataSourceDatabase(),
 			"civo_database_version":        database.DataDatabaseVersion(),
+			"civo_provider_info":           dataSourceProviderInfo(),
+			"civo_diagnostics":             dataSourceDiagnostics(),
+			"civo_importable_resources":    dataSourceImportableResources(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"civo_instance":                        instances.ResourceInstance(),
@@ -98,6 +177,7 @@ func Provider() *schema.Provider {
 			"civo_dns_domain_name":                 dns.ResourceDNSDomainName(),
 			"civo_dns_domain_record":               dns.ResourceDNSDomainRecord(),
 			"civo_firewall":                        firewall.ResourceFirewall(),
+			"civo_firewall_rule_set":               firewall.ResourceFirewallRuleSet(),
 			"civo_ssh_key":                         ssh.ResourceSSHKey(),
 			"civo_kubernetes_cluster":              kubernetes.ResourceKubernetesCluster(),
 			"civo_kubernetes_node_pool":            kubernetes.ResourceKubernetesClusterNodePool(),
@@ -105,9 +185,19 @@ func Provider() *schema.Provider {
 			"civo_object_store":                    objectstorage.ResourceObjectStore(),
 			"civo_object_store_credential":         objectstorage.ResourceObjectStoreCredential(),
 			"civo_database":                        database.ResourceDatabase(),
+			"civo_resource_tags":                   tags.ResourceTags(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	for _, r := range provider.DataSourcesMap {
+		withVerboseErrors(r)
+	}
+	for _, r := range provider.ResourcesMap {
+		withVerboseErrors(r)
+	}
+
+	return provider
 }
 
 // Provider configuration
@@ -133,6 +223,14 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	} else {
 		apiURL = ProdAPI
 	}
+
+	defaultAPIURL := apiURL
+	regionAPIURLs := map[string]string{}
+	for region, regionURL := range d.Get("region_api_urls").(map[string]interface{}) {
+		regionAPIURLs[region] = regionURL.(string)
+	}
+	apiURL = utils.APIURLForRegion(regionValue, apiURL, regionAPIURLs)
+
 	client, err = civogo.NewClientWithURL(tokenValue, apiURL, regionValue)
 	if err != nil {
 		return nil, err
@@ -156,8 +254,29 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, fmt.Errorf("an error occoured while connecting to Civo's API: %s", err)
 	}
 
+	tagFirewalls := map[string]string{}
+	for tag, firewallID := range d.Get("tag_firewall_map").(map[string]interface{}) {
+		tagFirewalls[tag] = firewallID.(string)
+	}
+
 	log.Printf("[DEBUG] Civo API URL: %s\n", apiURL)
-	return client, nil
+	return &utils.CombinedConfig{
+		Client:              client,
+		Region:              regionValue,
+		APIEndpoint:         apiURL,
+		DefaultAPIEndpoint:  defaultAPIURL,
+		RegionAPIURLs:       regionAPIURLs,
+		SkipQuotaCheck:      d.Get("skip_quota_check").(bool),
+		SkipCapacityCheck:   d.Get("skip_capacity_check").(bool),
+		LogStateTransitions: d.Get("log_state_transitions").(bool),
+		DefaultFirewallID:   d.Get("default_firewall_id").(string),
+		DefaultSSHKeyID:     d.Get("default_sshkey_id").(string),
+		TagFirewalls:        tagFirewalls,
+		PollJitterPercent:   d.Get("poll_jitter_percent").(int),
+		VerboseErrors:       d.Get("verbose_errors").(bool),
+		MaxPollInterval:     time.Duration(d.Get("max_poll_interval_seconds").(int)) * time.Second,
+		InstanceCreates:     utils.NewSemaphore(d.Get("max_concurrent_instances").(int)),
+	}, nil
 }
 
 func getToken(d *schema.ResourceData) (interface{}, bool, string) {
@@ -247,3 +366,23 @@ func validateTokenUsage(v interface{}, path cty.Path) diag.Diagnostics {
 
 	return diags
 }
+
+// validateRegionAPIURLs ensures every URL in the region_api_urls map is an absolute URL
+func validateRegionAPIURLs(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for region, raw := range v.(map[string]interface{}) {
+		value, _ := raw.(string)
+		u, err := url.Parse(value)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid region API URL",
+				Detail:        fmt.Sprintf("The API URL %q for the region %s is not a valid absolute URL", value, region),
+				AttributePath: path.IndexString(region),
+			})
+		}
+	}
+
+	return diags
+}
@@ -6,6 +6,7 @@ import (
 
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -23,6 +24,19 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+// TestValidateRegionAPIURLs tests the validation of the region_api_urls map
+func TestValidateRegionAPIURLs(t *testing.T) {
+	valid := map[string]interface{}{"SOV1": "https://api.sov1.example.com"}
+	if diags := validateRegionAPIURLs(valid, cty.Path{}); diags.HasError() {
+		t.Errorf("expected no errors, got %s", diagnosticsToString(diags))
+	}
+
+	invalid := map[string]interface{}{"SOV1": "api.sov1.example.com"}
+	if diags := validateRegionAPIURLs(invalid, cty.Path{}); !diags.HasError() {
+		t.Errorf("expected an error for a URL without scheme")
+	}
+}
+
 // TestToken tests the token configuration
 //func TestToken(t *testing.T) {
 //	t.Run("reading token from token attribute", func(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -46,7 +47,7 @@ func DataSourceRegion() *schema.Resource {
 }
 
 func getRegios(m interface{}, _ map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	regions := []interface{}{}
 	partialRegions, err := apiClient.ListRegions()
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -27,8 +27,15 @@ type Size struct {
 // The retrieved Instance Size can then be used to define the size for other resources or data sources.
 func DataSourceSize() *schema.Resource {
 	dataListConfig := &datalist.ResourceConfig{
-		Description:         "Retrieves information about the sizes that Civo supports, with the ability to filter the results.",
-		RecordSchema:        sizeSchema(),
+		Description:  "Retrieves information about the sizes that Civo supports, with the ability to filter the results.",
+		RecordSchema: sizeSchema(),
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If used, all sizes will be from the provided region, otherwise the region declared in the provider is used",
+			},
+		},
 		ResultAttributeName: "sizes",
 		FlattenRecord:       flattenSize,
 		GetRecords:          getSizes,
@@ -38,8 +45,19 @@ func DataSourceSize() *schema.Resource {
 
 }
 
-func getSizes(m interface{}, _ map[string]interface{}) ([]interface{}, error) {
-	apiClient := m.(*civogo.Client)
+func getSizes(m interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	config := m.(*utils.CombinedConfig)
+
+	region, ok := extra["region"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unable to find `region` key from query data")
+	}
+
+	region, err := utils.ResolveRegion(region, config)
+	if err != nil {
+		return nil, err
+	}
+	apiClient := utils.ClientForRegion(config, region)
 
 	sizes := []interface{}{}
 	partialSizes, err := apiClient.ListInstanceSizes()
@@ -2,6 +2,7 @@ package size_test
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"testing"
 
@@ -45,6 +46,24 @@ func TestAccDataSourceCivoSize_WithFilterAndSort(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceCivoSize_WithRegion(t *testing.T) {
+	datasourceName := "data.civo_size.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: DataSourceCivoSizeConfigWithRegion(os.Getenv("CIVO_REGION")),
+				Check: resource.ComposeTestCheckFunc(
+					DataSourceCivoSizeExist(datasourceName),
+					resource.TestCheckResourceAttr(datasourceName, "region", os.Getenv("CIVO_REGION")),
+				),
+			},
+		},
+	})
+}
+
 func DataSourceCivoSizeExist(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -120,6 +139,14 @@ data "civo_size" "foobar" {
 `
 }
 
+func DataSourceCivoSizeConfigWithRegion(region string) string {
+	return fmt.Sprintf(`
+data "civo_size" "foobar" {
+	region = "%s"
+}
+`, region)
+}
+
 func DataSourceCivoSizeConfigWhitFilterAndSort() string {
 	return `
 data "civo_size" "foobar" {
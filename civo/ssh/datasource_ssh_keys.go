@@ -0,0 +1,67 @@
+package ssh
+
+import (
+	"fmt"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/datalist"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceSSHKeys Data source to get and filter all the SSH keys of the account
+func DataSourceSSHKeys() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		Description:         "Get information on the SSH keys of your Civo account, with the ability to filter and sort the results. If no filters are specified, all SSH keys will be returned.",
+		RecordSchema:        sshKeysSchema(),
+		ResultAttributeName: "keys",
+		FlattenRecord:       flattenDataSourceSSHKeys,
+		GetRecords:          getDataSourceSSHKeys,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDataSourceSSHKeys(m interface{}, _ map[string]interface{}) ([]interface{}, error) {
+	apiClient := m.(*utils.CombinedConfig).Client
+
+	sshKeys, err := apiClient.ListSSHKeys()
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] error retrieving SSH keys: %s", err)
+	}
+
+	keys := []interface{}{}
+	for _, key := range sshKeys {
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func flattenDataSourceSSHKeys(sshKey, _ interface{}, _ map[string]interface{}) (map[string]interface{}, error) {
+	key := sshKey.(civogo.SSHKey)
+
+	flattenedKey := map[string]interface{}{}
+	flattenedKey["id"] = key.ID
+	flattenedKey["name"] = key.Name
+	flattenedKey["fingerprint"] = key.Fingerprint
+
+	return flattenedKey, nil
+}
+
+func sshKeysSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "ID of the SSH key",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the SSH key",
+		},
+		"fingerprint": {
+			Type:        schema.TypeString,
+			Description: "Fingerprint of the public key of the SSH key",
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package ssh_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceCivoSSHKeys_filterByPrefix(t *testing.T) {
+	datasourceName := "data.civo_ssh_keys.foobar"
+	prefix := acctest.RandomWithPrefix("sshkeys-test")
+	pubKey, err := GenerateDataSourceCivoSSHKeyPublic()
+	if err != nil {
+		t.Fatalf("Unable to generate public key: %v", err)
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.TestAccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: DataSourceCivoSSHKeysConfig(prefix, pubKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "keys.0.name", prefix+"-web"),
+					resource.TestCheckResourceAttrSet(datasourceName, "keys.0.id"),
+					resource.TestCheckResourceAttrSet(datasourceName, "keys.0.fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+func DataSourceCivoSSHKeysConfig(prefix string, key string) string {
+	return fmt.Sprintf(`
+resource "civo_ssh_key" "foobar" {
+	name = "%s-web"
+    public_key = "%s"
+}
+
+data "civo_ssh_keys" "foobar" {
+	filter {
+		key = "name"
+		values = ["%s"]
+		match_by = "prefix"
+	}
+
+	depends_on = [civo_ssh_key.foobar]
+}
+`, prefix, key, prefix)
+}
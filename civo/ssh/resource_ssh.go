@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 
-	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -47,7 +46,7 @@ func ResourceSSHKey() *schema.Resource {
 
 // function to create a new ssh key
 func resourceSSHKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	log.Printf("[INFO] creating the new ssh key %s", d.Get("name").(string))
 	sshKey, err := apiClient.NewSSHKey(d.Get("name").(string), d.Get("public_key").(string))
@@ -62,7 +61,7 @@ func resourceSSHKeyCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 // function to read a ssh key
 func resourceSSHKeyRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	log.Printf("[INFO] retrieving the new ssh key %s", d.Get("name").(string))
 	sshKey, err := apiClient.FindSSHKey(d.Id())
@@ -83,7 +82,7 @@ func resourceSSHKeyRead(_ context.Context, d *schema.ResourceData, m interface{}
 
 // function to update the ssh key
 func resourceSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	if d.HasChange("name") {
 		if d.Get("name").(string) != "" {
@@ -100,7 +99,7 @@ func resourceSSHKeyUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 // function to delete the ssh key
 func resourceSSHKeyDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	apiClient := m.(*utils.CombinedConfig).Client
 
 	log.Printf("[INFO] deleting the ssh key %s", d.Id())
 	_, err := apiClient.DeleteSSHKey(d.Id())
@@ -6,6 +6,7 @@ import (
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -105,7 +106,7 @@ func CivoSSHKeyResourceExists(n string, SSHKey *civogo.SSHKey) resource.TestChec
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 		resp, err := client.FindSSHKey(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Ssh key not found: (%s) %s", rs.Primary.ID, err)
@@ -129,7 +130,7 @@ func CivoSSHKeyUpdated(SSHKey *civogo.SSHKey, name string) resource.TestCheckFun
 }
 
 func CivoSSHKeyDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_ssh_key" {
@@ -0,0 +1,314 @@
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceTagger reads and writes the tags of one type of resource
+type resourceTagger struct {
+	// get returns the current tags of the resource, notFound is the error the API returns when it doesn't exist
+	get      func(apiClient *civogo.Client, id string) ([]string, error)
+	set      func(apiClient *civogo.Client, id string, tags []string) error
+	notFound error
+}
+
+// taggers are the types of resources which can be tagged, the Civo API only supports tags on instances and Kubernetes clusters
+var taggers = map[string]resourceTagger{
+	"instance": {
+		get: func(apiClient *civogo.Client, id string) ([]string, error) {
+			instance, err := apiClient.GetInstance(id)
+			if err != nil {
+				return nil, err
+			}
+			return instance.Tags, nil
+		},
+		set: func(apiClient *civogo.Client, id string, tags []string) error {
+			_, err := apiClient.SetInstanceTags(&civogo.Instance{ID: id}, strings.Join(tags, " "))
+			return err
+		},
+		notFound: civogo.DatabaseInstanceNotFoundError,
+	},
+	"kubernetes_cluster": {
+		get: func(apiClient *civogo.Client, id string) ([]string, error) {
+			cluster, err := apiClient.GetKubernetesCluster(id)
+			if err != nil {
+				return nil, err
+			}
+			return cluster.Tags, nil
+		},
+		set: func(apiClient *civogo.Client, id string, tags []string) error {
+			// the tags of KubernetesClusterConfig are omitted when empty, so removing the last
+			// tags has to send the empty tags in a request of its own
+			if len(tags) == 0 {
+				_, err := apiClient.SendPutRequest(fmt.Sprintf("/v2/kubernetes/clusters/%s", id), map[string]string{
+					"region": apiClient.Region,
+					"tags":   "",
+				})
+				return err
+			}
+
+			_, err := apiClient.UpdateKubernetesCluster(id, &civogo.KubernetesClusterConfig{
+				Region: apiClient.Region,
+				Tags:   strings.Join(tags, " "),
+			})
+			return err
+		},
+		notFound: civogo.DatabaseKubernetesClusterNotFoundError,
+	},
+}
+
+// taggableResourceTypes returns the types of resources which can be tagged
+func taggableResourceTypes() []string {
+	types := make([]string, 0, len(taggers))
+	for t := range taggers {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ResourceTags applies a set of tags to a resource which doesn't need to be managed by Terraform
+func ResourceTags() *schema.Resource {
+	return &schema.Resource{
+		Description: strings.Join([]string{
+			"Applies a set of tags to an existing resource, which doesn't need to be managed by Terraform. Only the tags declared here are managed, any other tag on the resource is kept, and they are removed from the resource when this is destroyed.",
+			"Don't use this together with the `tags` argument of a resource managed by Terraform, as both will try to manage the same tags.",
+		}, "\n\n"),
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(taggableResourceTypes(), false),
+				Description:  "The type of the resource to tag, one of " + utils.GetCommaSeparatedAllowedKeys(taggableResourceTypes()),
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: utils.ValidateUUID,
+				Description:  "The ID of the resource to tag",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The region of the resource, if not declared we use the region in declared in the provider",
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MaxItems:    utils.MaxTags,
+				Description: "The tags to apply to the resource",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: utils.ValidateTag,
+				},
+			},
+		},
+		CreateContext: resourceTagsCreate,
+		ReadContext:   resourceTagsRead,
+		UpdateContext: resourceTagsUpdate,
+		DeleteContext: resourceTagsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTagsImport,
+		},
+	}
+}
+
+// function to apply the tags to the resource
+func resourceTagsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
+
+	resourceType := d.Get("resource_type").(string)
+	resourceID := d.Get("resource_id").(string)
+	tagger := taggers[resourceType]
+
+	current, err := tagger.get(apiClient, resourceID)
+	if err != nil {
+		return diag.Errorf("[ERR] failed to retrieve the %s %s: %s", resourceType, resourceID, err)
+	}
+
+	log.Printf("[INFO] adding tags to the %s %s", resourceType, resourceID)
+	if err := tagger.set(apiClient, resourceID, mergeTags(current, nil, expandTags(d.Get("tags")))); err != nil {
+		return diag.Errorf("[ERR] an error occurred while adding tags to the %s %s: %s", resourceType, resourceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", resourceType, resourceID))
+
+	return resourceTagsRead(ctx, d, m)
+}
+
+// function to read the tags, only the ones managed by this resource are kept in the state
+func resourceTagsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
+
+	resourceType := d.Get("resource_type").(string)
+	resourceID := d.Get("resource_id").(string)
+	tagger := taggers[resourceType]
+
+	log.Printf("[INFO] retrieving the tags of the %s %s", resourceType, resourceID)
+	current, err := tagger.get(apiClient, resourceID)
+	if err != nil {
+		if errors.Is(err, tagger.notFound) {
+			log.Printf("[WARN] the %s %s no longer exists, removing the tags from the state", resourceType, resourceID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERR] failed to retrieve the %s %s: %s", resourceType, resourceID, err)
+	}
+
+	d.Set("tags", intersectTags(current, expandTags(d.Get("tags"))))
+
+	return nil
+}
+
+// function to update the tags, the tags removed from the configuration are removed from the resource
+func resourceTagsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
+
+	resourceType := d.Get("resource_type").(string)
+	resourceID := d.Get("resource_id").(string)
+	tagger := taggers[resourceType]
+
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+
+		current, err := tagger.get(apiClient, resourceID)
+		if err != nil {
+			return diag.Errorf("[ERR] failed to retrieve the %s %s: %s", resourceType, resourceID, err)
+		}
+
+		log.Printf("[INFO] updating the tags of the %s %s", resourceType, resourceID)
+		if err := tagger.set(apiClient, resourceID, mergeTags(current, expandTags(oldTags), expandTags(newTags))); err != nil {
+			return diag.Errorf("[ERR] an error occurred while updating the tags of the %s %s: %s", resourceType, resourceID, err)
+		}
+	}
+
+	return resourceTagsRead(ctx, d, m)
+}
+
+// function to remove the tags managed by this resource
+func resourceTagsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
+
+	resourceType := d.Get("resource_type").(string)
+	resourceID := d.Get("resource_id").(string)
+	tagger := taggers[resourceType]
+
+	current, err := tagger.get(apiClient, resourceID)
+	if err != nil {
+		if errors.Is(err, tagger.notFound) {
+			return nil
+		}
+		return diag.Errorf("[ERR] failed to retrieve the %s %s: %s", resourceType, resourceID, err)
+	}
+
+	log.Printf("[INFO] removing tags from the %s %s", resourceType, resourceID)
+	if err := tagger.set(apiClient, resourceID, mergeTags(current, expandTags(d.Get("tags")), nil)); err != nil {
+		return diag.Errorf("[ERR] an error occurred while removing tags from the %s %s: %s", resourceType, resourceID, err)
+	}
+
+	return nil
+}
+
+// function to import the tags, all the current tags of the resource are managed after the import.
+// The ID is type:id, or region:type:id for a resource outside the region of the provider
+func resourceTagsImport(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if parts := strings.Split(d.Id(), ":"); len(parts) == 3 {
+		if parts[0] == "" {
+			return nil, fmt.Errorf("[ERR] the region of the import ID %s is empty", d.Id())
+		}
+		d.Set("region", parts[0])
+		d.SetId(fmt.Sprintf("%s:%s", parts[1], parts[2]))
+	}
+
+	resourceType, resourceID, err := utils.ResourceCommonParseID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
+
+	tagger, ok := taggers[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("[ERR] the resource type %s can't be tagged, it must be one of %s", resourceType, strings.Join(taggableResourceTypes(), ", "))
+	}
+
+	current, err := tagger.get(apiClient, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] failed to retrieve the %s %s: %s", resourceType, resourceID, err)
+	}
+
+	d.Set("resource_type", resourceType)
+	d.Set("resource_id", resourceID)
+	d.Set("tags", current)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandTags returns the tags of a schema.Set as a list of strings
+func expandTags(tags interface{}) []string {
+	list := tags.(*schema.Set).List()
+	expanded := make([]string, len(list))
+	for i, tag := range list {
+		expanded[i] = tag.(string)
+	}
+	return expanded
+}
+
+// mergeTags returns the current tags without the removed tags and with the added tags, keeping
+// the order of the current tags and any tag not managed by Terraform
+func mergeTags(current, removed, added []string) []string {
+	removedSet := map[string]bool{}
+	for _, tag := range removed {
+		removedSet[tag] = true
+	}
+
+	seen := map[string]bool{}
+	merged := []string{}
+	for _, tag := range current {
+		if tag == "" || removedSet[tag] || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		merged = append(merged, tag)
+	}
+
+	for _, tag := range added {
+		if !seen[tag] {
+			seen[tag] = true
+			merged = append(merged, tag)
+		}
+	}
+
+	return merged
+}
+
+// intersectTags returns the managed tags which are still on the resource
+func intersectTags(current, managed []string) []string {
+	currentSet := map[string]bool{}
+	for _, tag := range current {
+		currentSet[tag] = true
+	}
+
+	kept := []string{}
+	for _, tag := range managed {
+		if currentSet[tag] {
+			kept = append(kept, tag)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,159 @@
+package tags_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccCivoResourceTags_instance tags an instance which has tags of its own, these must be kept
+func TestAccCivoResourceTags_instance(t *testing.T) {
+	var instance civogo.Instance
+
+	resName := "civo_resource_tags.foobar"
+	var instanceHostname = acctest.RandomWithPrefix("tf-test") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoResourceTagsConfigInstance(instanceHostname, `["web", "production"]`),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CivoInstanceResourceExists("civo_instance.foobar", &instance),
+					CivoResourceTagsInstanceHasTags(&instance, "owned", "web", "production"),
+					resource.TestCheckResourceAttr(resName, "resource_type", "instance"),
+					resource.TestCheckResourceAttr(resName, "tags.#", "2"),
+				),
+			},
+			{
+				Config: CivoResourceTagsConfigInstance(instanceHostname, `["web"]`),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.CivoInstanceResourceExists("civo_instance.foobar", &instance),
+					CivoResourceTagsInstanceHasTags(&instance, "owned", "web"),
+					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccCivoResourceTags_kubernetesCluster tags a Kubernetes cluster
+func TestAccCivoResourceTags_kubernetesCluster(t *testing.T) {
+	resName := "civo_resource_tags.foobar"
+	var clusterName = acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acceptance.TestAccPreCheck(t) },
+		Providers:    acceptance.TestAccProviders,
+		CheckDestroy: acceptance.CivoKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: CivoResourceTagsConfigKubernetesCluster(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "resource_type", "kubernetes_cluster"),
+					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "team-a"),
+				),
+			},
+		},
+	})
+}
+
+func CivoResourceTagsInstanceHasTags(instance *civogo.Instance, tags ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if len(instance.Tags) != len(tags) {
+			return fmt.Errorf("bad tags, expected %v, got: %v", tags, instance.Tags)
+		}
+
+		for _, tag := range tags {
+			found := false
+			for _, instanceTag := range instance.Tags {
+				if instanceTag == tag {
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Errorf("the tag %s is missing from the instance, got: %v", tag, instance.Tags)
+			}
+		}
+
+		return nil
+	}
+}
+
+func CivoResourceTagsConfigInstance(hostname string, tags string) string {
+	return fmt.Sprintf(`
+data "civo_size" "small" {
+	filter {
+		key = "name"
+		values = ["g3.small"]
+		match_by = "re"
+	}
+
+	filter {
+		key = "type"
+		values = ["instance"]
+	}
+}
+
+# Query instance disk image
+data "civo_disk_image" "debian" {
+	filter {
+		key = "name"
+		values = ["debian-10"]
+	}
+}
+
+resource "civo_instance" "foobar" {
+	hostname = "%s"
+	size = element(data.civo_size.small.sizes, 0).name
+	disk_image = element(data.civo_disk_image.debian.diskimages, 0).id
+	tags = ["owned"]
+
+	lifecycle {
+		ignore_changes = [tags]
+	}
+}
+
+resource "civo_resource_tags" "foobar" {
+	resource_type = "instance"
+	resource_id = civo_instance.foobar.id
+	tags = %s
+}
+`, hostname, tags)
+}
+
+func CivoResourceTagsConfigKubernetesCluster(name string) string {
+	return fmt.Sprintf(`
+resource "civo_firewall" "default" {
+	name = "%s"
+	create_default_rules = true
+}
+
+resource "civo_kubernetes_cluster" "foobar" {
+	name = "%s"
+	firewall_id = civo_firewall.default.id
+	pools {
+		node_count = 2
+		size = "g4s.kube.small"
+	}
+
+	lifecycle {
+		ignore_changes = [tags]
+	}
+}
+
+resource "civo_resource_tags" "foobar" {
+	resource_type = "kubernetes_cluster"
+	resource_id = civo_kubernetes_cluster.foobar.id
+	tags = ["team-a"]
+}
+`, name, name)
+}
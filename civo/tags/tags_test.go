@@ -0,0 +1,128 @@
+package tags
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestMergeTags(t *testing.T) {
+	cases := []struct {
+		name     string
+		current  []string
+		removed  []string
+		added    []string
+		expected []string
+	}{
+		{"add to existing tags", []string{"owned"}, nil, []string{"web"}, []string{"owned", "web"}},
+		{"no duplicates", []string{"owned", "web"}, nil, []string{"web"}, []string{"owned", "web"}},
+		{"replace managed tags", []string{"owned", "web", "prod"}, []string{"web", "prod"}, []string{"web"}, []string{"owned", "web"}},
+		{"remove managed tags", []string{"owned", "web"}, []string{"web"}, nil, []string{"owned"}},
+		{"empty tags from the API", []string{""}, nil, []string{"web"}, []string{"web"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := mergeTags(c.current, c.removed, c.added); !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestIntersectTags(t *testing.T) {
+	got := intersectTags([]string{"owned", "web"}, []string{"web", "prod"})
+	if !reflect.DeepEqual(got, []string{"web"}) {
+		t.Errorf("expected only the managed tags still on the resource, got %v", got)
+	}
+}
+
+func TestKubernetesClusterTaggerClearsTags(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut || req.URL.Path != "/v2/kubernetes/clusters/12345" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewDecoder(req.Body).Decode(&body)
+		rw.Write([]byte(`{"id": "12345"}`))
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	// removing the last tags sends the empty tags instead of leaving them out
+	if err := taggers["kubernetes_cluster"].set(client, "12345", []string{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if tags, ok := body["tags"]; !ok || tags != "" {
+		t.Errorf("expected empty tags to be sent, got %v", body)
+	}
+	if body["region"] != client.Region {
+		t.Errorf("expected the region %s to be sent, got %v", client.Region, body["region"])
+	}
+}
+
+func TestResourceTagsImportRegion(t *testing.T) {
+	regions := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v2/instances/12345" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		regions = append(regions, req.URL.Query().Get("region"))
+		rw.Write([]byte(`{"id": "12345", "tags": ["web"]}`))
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+	config := &utils.CombinedConfig{Client: client, Region: client.Region}
+
+	cases := []struct {
+		id     string
+		region string
+	}{
+		{"instance:12345", client.Region},
+		{"NYC1:instance:12345", "NYC1"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.id, func(t *testing.T) {
+			regions = regions[:0]
+			d := schema.TestResourceDataRaw(t, ResourceTags().Schema, map[string]interface{}{})
+			d.SetId(c.id)
+
+			if _, err := resourceTagsImport(context.Background(), d, config); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if d.Id() != "instance:12345" {
+				t.Errorf("expected the ID to be instance:12345, got %s", d.Id())
+			}
+			if !reflect.DeepEqual(regions, []string{c.region}) {
+				t.Errorf("expected the instance to be retrieved in %s, got %v", c.region, regions)
+			}
+			if got := expandTags(d.Get("tags")); !reflect.DeepEqual(got, []string{"web"}) {
+				t.Errorf("expected the tags to be imported, got %v", got)
+			}
+		})
+	}
+
+	if client.Region == "NYC1" {
+		t.Errorf("expected the region of the shared client to be left alone")
+	}
+}
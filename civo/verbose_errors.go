@@ -0,0 +1,153 @@
+package civo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// verboseResponseLength is how much of the API response is added to an error in verbose mode
+const verboseResponseLength = 500
+
+// withVerboseErrors wraps the functions of the resource so their errors get the API response
+// in their detail when the provider has verbose_errors enabled
+func withVerboseErrors(r *schema.Resource) *schema.Resource {
+	r.CreateContext = verboseContextFunc(r.CreateContext)
+	r.ReadContext = verboseContextFunc(r.ReadContext)
+	r.UpdateContext = verboseContextFunc(r.UpdateContext)
+	r.DeleteContext = verboseContextFunc(r.DeleteContext)
+	r.CustomizeDiff = verboseCustomizeDiffFunc(r.CustomizeDiff)
+	if r.Importer != nil {
+		r.Importer.StateContext = verboseImportFunc(r.Importer.StateContext)
+	}
+	return r
+}
+
+// verboseConfig returns the config the wrapped function is called with, and whether its errors get
+// the API response. The call gets clients of its own, so the response isn't the one of another
+// resource handled at the same time and the clients scoped to a region are seen as well
+func verboseConfig(m interface{}) (interface{}, bool) {
+	config, ok := m.(*utils.CombinedConfig)
+	if !ok || !config.VerboseErrors {
+		return m, false
+	}
+	return config.RecordClients(), true
+}
+
+func verboseContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		m, verbose := verboseConfig(m)
+		if !verbose {
+			return f(ctx, d, m)
+		}
+		return verboseDiagnostics(f(ctx, d, m), m.(*utils.CombinedConfig).LastAPIResponse())
+	}
+}
+
+func verboseCustomizeDiffFunc(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		m, verbose := verboseConfig(m)
+		if !verbose {
+			return f(ctx, d, m)
+		}
+		return verboseError(f(ctx, d, m), m.(*utils.CombinedConfig).LastAPIResponse())
+	}
+}
+
+func verboseImportFunc(f schema.StateContextFunc) schema.StateContextFunc {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		m, verbose := verboseConfig(m)
+		if !verbose {
+			return f(ctx, d, m)
+		}
+		data, err := f(ctx, d, m)
+		return data, verboseError(err, m.(*utils.CombinedConfig).LastAPIResponse())
+	}
+}
+
+// verboseDiagnostics adds the redacted API response to the detail of the errors
+func verboseDiagnostics(diags diag.Diagnostics, response string) diag.Diagnostics {
+	for i := range diags {
+		if diags[i].Severity != diag.Error {
+			continue
+		}
+		detail := verboseDetail(diags[i].Summary+" "+diags[i].Detail, response)
+		if detail == "" {
+			continue
+		}
+		if diags[i].Detail != "" {
+			detail = diags[i].Detail + "\n\n" + detail
+		}
+		diags[i].Detail = detail
+	}
+	return diags
+}
+
+// verboseError adds the redacted API response to an error, for the functions returning errors
+// instead of diagnostics
+func verboseError(err error, response string) error {
+	if err == nil {
+		return nil
+	}
+	detail := verboseDetail(err.Error(), response)
+	if detail == "" {
+		return err
+	}
+	return fmt.Errorf("%w\n\n%s", err, detail)
+}
+
+// verboseDetail describes the last API response for an error with the given message, or returns an
+// empty string when no response was received
+func verboseDetail(message, response string) string {
+	if response == "" {
+		return ""
+	}
+
+	redacted := utils.RedactedAPIResponse(response, verboseResponseLength)
+	if status := apiStatus(message, response); status != "" {
+		return fmt.Sprintf("Last response of the Civo API (HTTP status %s): %s", status, redacted)
+	}
+	return fmt.Sprintf("Last response of the Civo API: %s", redacted)
+}
+
+// decodeFailedStatus matches the status civogo puts in the error of a response it couldn't decode
+var decodeFailedStatus = regexp.MustCompile(`status: (\d{3}[^,]*), code: \d{3}`)
+
+// apiStatus returns the HTTP status of the last response when it's known. civogo drops the status
+// from its errors, except for the responses it couldn't decode, so the message of the error is looked
+// at first and then the status the API adds to the body of some errors
+func apiStatus(message, response string) string {
+	if match := decodeFailedStatus.FindStringSubmatch(message); match != nil {
+		return match[1]
+	}
+
+	body := struct {
+		Status interface{} `json:"status"`
+	}{}
+	if err := json.Unmarshal([]byte(response), &body); err == nil {
+		// the status of a resource, e.g. "ACTIVE", is a string
+		if status, ok := body.Status.(float64); ok && status >= 100 && status < 600 {
+			return fmt.Sprintf("%d %s", int(status), http.StatusText(int(status)))
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,193 @@
+package civo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestVerboseErrors compares the concise and verbose errors of a failed API call
+func TestVerboseErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadRequest)
+		rw.Write([]byte(`{"code": "database_instance_find", "reason": "The instance could not be found", "initial_password": "hunter2"}`))
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	read := verboseContextFunc(func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if _, err := m.(*utils.CombinedConfig).Client.GetInstance("12345"); err != nil {
+			return diag.Errorf("[ERR] failed to retrieve the instance: %s", err)
+		}
+		return nil
+	})
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+
+	concise := read(context.Background(), d, &utils.CombinedConfig{Client: client})
+	if len(concise) != 1 || concise[0].Detail != "" {
+		t.Fatalf("expected a single error without detail, got %v", concise)
+	}
+
+	verbose := read(context.Background(), d, &utils.CombinedConfig{Client: client, VerboseErrors: true})
+	if len(verbose) != 1 {
+		t.Fatalf("expected a single error, got %v", verbose)
+	}
+	if verbose[0].Summary != concise[0].Summary {
+		t.Errorf("expected the summary to be kept, got %q", verbose[0].Summary)
+	}
+	if !strings.Contains(verbose[0].Detail, `"code":"database_instance_find"`) {
+		t.Errorf("expected the API response in the detail, got %q", verbose[0].Detail)
+	}
+	if strings.Contains(verbose[0].Detail, "hunter2") {
+		t.Errorf("expected the password to be redacted, got %q", verbose[0].Detail)
+	}
+}
+
+// TestVerboseErrorsRegionClient checks the response is found when the call uses a client scoped to a
+// region, and that the response of another resource on the shared client isn't shown
+func TestVerboseErrorsRegionClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadRequest)
+		rw.Write([]byte(`{"code": "database_instance_find", "reason": "The instance could not be found"}`))
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+	// the response another resource received on the shared client
+	client.LastJSONResponse = `{"id": "other-resource"}`
+	config := &utils.CombinedConfig{Client: client, VerboseErrors: true}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+
+	read := verboseContextFunc(func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if _, err := utils.ClientForRegion(m.(*utils.CombinedConfig), "NYC1").GetInstance("12345"); err != nil {
+			return diag.Errorf("[ERR] failed to retrieve the instance: %s", err)
+		}
+		return nil
+	})
+	diags := read(context.Background(), d, config)
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, `"code":"database_instance_find"`) {
+		t.Errorf("expected the API response in the detail, got %v", diags)
+	}
+	if client.LastJSONResponse != `{"id": "other-resource"}` {
+		t.Errorf("expected the shared client to be left alone, got %q", client.LastJSONResponse)
+	}
+
+	// a call failing without reaching the API has no response to show
+	noCall := verboseContextFunc(func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.Errorf("[ERR] invalid configuration")
+	})
+	diags = noCall(context.Background(), d, config)
+	if len(diags) != 1 || diags[0].Detail != "" {
+		t.Errorf("expected no response in the detail, got %v", diags)
+	}
+}
+
+// TestVerboseErrorsStatus checks the HTTP status is shown whenever it can be told from the error or
+// the response
+func TestVerboseErrorsStatus(t *testing.T) {
+	cases := []struct {
+		name     string
+		status   int
+		body     string
+		expected string
+	}{
+		{"response civogo can't decode", http.StatusBadGateway, `<html>Bad Gateway</html>`, "(HTTP status 502 Bad Gateway)"},
+		{"status in the response", http.StatusInternalServerError, `{"status": 500, "error": "Internal Server Error"}`, "(HTTP status 500 Internal Server Error)"},
+		{"status unknown", http.StatusBadRequest, `{"code": "database_instance_find", "reason": "The instance could not be found"}`, "Last response of the Civo API: "},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				rw.WriteHeader(c.status)
+				rw.Write([]byte(c.body))
+			}))
+			defer server.Close()
+
+			client, err := civogo.NewClientForTestingWithServer(server)
+			if err != nil {
+				t.Fatalf("failed to create the client: %s", err)
+			}
+
+			read := verboseContextFunc(func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				if _, err := m.(*utils.CombinedConfig).Client.GetInstance("12345"); err != nil {
+					return diag.Errorf("[ERR] failed to retrieve the instance: %s", err)
+				}
+				return nil
+			})
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+
+			diags := read(context.Background(), d, &utils.CombinedConfig{Client: client, VerboseErrors: true})
+			if len(diags) != 1 || !strings.Contains(diags[0].Detail, c.expected) {
+				t.Errorf("expected %q in the detail, got %v", c.expected, diags)
+			}
+		})
+	}
+}
+
+// TestVerboseErrorsDiffAndImport checks the errors of the plan and of the imports get the response too
+func TestVerboseErrorsDiffAndImport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		rw.Write([]byte(`upstream unavailable`))
+	}))
+	defer server.Close()
+
+	client, err := civogo.NewClientForTestingWithServer(server)
+	if err != nil {
+		t.Fatalf("failed to create the client: %s", err)
+	}
+
+	r := withVerboseErrors(&schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}},
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+			_, err := m.(*utils.CombinedConfig).Client.ListInstanceSizes()
+			return err
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				if _, err := m.(*utils.CombinedConfig).Client.GetInstance(d.Id()); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	})
+	config := &utils.CombinedConfig{Client: client, VerboseErrors: true}
+
+	_, err = r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "web"}), config)
+	if err == nil || !strings.Contains(err.Error(), "(HTTP status 503 Service Unavailable)") {
+		t.Errorf("expected the status in the error of the plan, got %v", err)
+	}
+	if !errors.Is(err, civogo.ResponseDecodeFailedError) {
+		t.Errorf("expected the error of civogo to be wrapped, got %v", err)
+	}
+
+	d := r.Data(&terraform.InstanceState{ID: "12345"})
+	_, err = r.Importer.StateContext(context.Background(), d, config)
+	if err == nil || !strings.Contains(err.Error(), "(HTTP status 503 Service Unavailable)") {
+		t.Errorf("expected the status in the error of the import, got %v", err)
+	}
+
+	// without verbose_errors the errors are left alone
+	_, err = r.Importer.StateContext(context.Background(), d, &utils.CombinedConfig{Client: client})
+	if err == nil || strings.Contains(err.Error(), "Last response of the Civo API") {
+		t.Errorf("expected the error without the response, got %v", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -61,12 +62,13 @@ func DataSourceVolume() *schema.Resource {
 }
 
 func dataSourceVolumeRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
+	config := m.(*utils.CombinedConfig)
 
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
+	region, err := utils.ResolveRegion(d.Get("region").(string), config)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	apiClient := utils.ClientForRegion(config, region)
 
 	var foundVolume *civogo.Volume
 
@@ -96,7 +98,7 @@ func dataSourceVolumeRead(_ context.Context, d *schema.ResourceData, m interface
 	d.Set("name", foundVolume.Name)
 	d.Set("size_gb", foundVolume.SizeGigabytes)
 	d.Set("mount_point", foundVolume.MountPoint)
-	d.Set("created_at", foundVolume.CreatedAt.UTC().String())
+	d.Set("created_at", utils.FormatTime(foundVolume.CreatedAt))
 
 	return nil
 }
@@ -35,6 +35,11 @@ func ResourceVolume() *schema.Resource {
 				Optional:    true,
 				Description: "The region for the volume, if not declare we use the region in declared in the provider.",
 			},
+			"urn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The uniform resource name (URN) of the volume, in the form `civo:volume:<region>:<id>`",
+			},
 			"network_id": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -59,12 +64,7 @@ func ResourceVolume() *schema.Resource {
 
 // function to create the new volume
 func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] configuring the volume %s", d.Get("name").(string))
 	config := &civogo.VolumeConfig{
@@ -89,16 +89,17 @@ func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{"creating"},
 		Target:  []string{"available"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("volume %s", d.Id()), func() (interface{}, string, error) {
 			resp, err := apiClient.FindVolume(d.Id())
 			if err != nil {
 				return 0, "", err
 			}
 			return resp, resp.Status, nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 10,
 	}
 	_, err = createStateConf.WaitForStateContext(context.Background())
@@ -111,12 +112,7 @@ func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 // function to read the volume
 func resourceVolumeRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] retrieving the volume %s", d.Id())
 	resp, err := apiClient.FindVolume(d.Id())
@@ -132,6 +128,7 @@ func resourceVolumeRead(_ context.Context, d *schema.ResourceData, m interface{}
 	d.Set("network_id", resp.NetworkID)
 	d.Set("size_gb", resp.SizeGigabytes)
 	d.Set("mount_point", resp.MountPoint)
+	d.Set("urn", utils.URN("volume", apiClient.Region, d.Id()))
 
 	return nil
 }
@@ -139,7 +136,7 @@ func resourceVolumeRead(_ context.Context, d *schema.ResourceData, m interface{}
 // function to update the volume
 func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
-	// apiClient := m.(*civogo.Client)
+	// apiClient := m.(*utils.CombinedConfig).Client
 
 	// // overwrite the region if is define in the datasource
 	// if region, ok := d.GetOk("region"); ok {
@@ -200,12 +197,7 @@ func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 // function to delete the volume
 func resourceVolumeDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if is define in the datasource
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	log.Printf("[INFO] deleting the volume %s", d.Id())
 	_, err := apiClient.DeleteVolume(d.Id())
@@ -217,8 +209,8 @@ func resourceVolumeDelete(_ context.Context, d *schema.ResourceData, m interface
 
 // custom import to able to import a volume
 func resourceVolumeImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	apiClient := m.(*civogo.Client)
-	regions, err := apiClient.ListRegions()
+	config := m.(*utils.CombinedConfig)
+	regions, err := config.Client.ListRegions()
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +222,7 @@ func resourceVolumeImport(d *schema.ResourceData, m interface{}) ([]*schema.Reso
 		}
 
 		currentRegion := region.Code
-		apiClient.Region = currentRegion
+		apiClient := utils.ClientForRegion(config, currentRegion)
 
 		volumes, err := apiClient.ListVolumes()
 		if err != nil {
@@ -6,7 +6,7 @@ import (
 	"log"
 	"time"
 
-	"github.com/civo/civogo"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -48,12 +48,7 @@ func ResourceVolumeAttachment() *schema.Resource {
 
 // function to create the new volume
 func resourceVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	instanceID := d.Get("instance_id").(string)
 	volumeID := d.Get("volume_id").(string)
@@ -77,16 +72,17 @@ func resourceVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData,
 	createStateConf := &resource.StateChangeConf{
 		Pending: []string{"attaching"},
 		Target:  []string{"attached"},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: m.(*utils.CombinedConfig).JitterPolls(ctx, m.(*utils.CombinedConfig).LogTransitions(ctx, fmt.Sprintf("volume %s", volumeID), func() (interface{}, string, error) {
 			resp, err := apiClient.FindVolume(volumeID)
 			if err != nil {
 				return 0, "", err
 			}
 			return resp, resp.Status, nil
-		},
+		})),
 		Timeout:        60 * time.Minute,
-		Delay:          3 * time.Second,
-		MinTimeout:     3 * time.Second,
+		Delay:          m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		MinTimeout:     m.(*utils.CombinedConfig).PollDelay(3 * time.Second),
+		PollInterval:   m.(*utils.CombinedConfig).PollInterval(),
 		NotFoundChecks: 10,
 	}
 	_, err = createStateConf.WaitForStateContext(context.Background())
@@ -99,12 +95,7 @@ func resourceVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData,
 
 // function to read the volume
 func resourceVolumeAttachmentRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	instanceID := d.Get("instance_id").(string)
 	volumeID := d.Get("volume_id").(string)
@@ -130,12 +121,7 @@ func resourceVolumeAttachmentRead(_ context.Context, d *schema.ResourceData, m i
 
 // function to delete the volume
 func resourceVolumeAttachmentDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	apiClient := m.(*civogo.Client)
-
-	// overwrite the region if it's defined
-	if region, ok := d.GetOk("region"); ok {
-		apiClient.Region = region.(string)
-	}
+	apiClient := utils.ClientForRegion(m.(*utils.CombinedConfig), d.Get("region").(string))
 
 	volumeID := d.Get("volume_id").(string)
 
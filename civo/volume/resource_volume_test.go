@@ -2,10 +2,12 @@ package volume_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/civo/civogo"
 	"github.com/civo/terraform-provider-civo/civo/acceptance"
+	"github.com/civo/terraform-provider-civo/internal/utils"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -36,6 +38,7 @@ func TestAccCivoVolume_basic(t *testing.T) {
 					// verify local values
 					resource.TestCheckResourceAttr(resName, "name", VolumeName),
 					resource.TestCheckResourceAttr(resName, "size_gb", "10"),
+					resource.TestMatchResourceAttr(resName, "urn", regexp.MustCompile(`^civo:volume:[a-z0-9-]+:[0-9a-f-]+$`)),
 				),
 			},
 		},
@@ -61,7 +64,7 @@ func CivoVolumeResourceExists(n string, volume *civogo.Volume) resource.TestChec
 		}
 
 		// retrieve the configured client from the test setup
-		client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+		client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 		resp, err := client.FindVolume(rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Volume not found: (%s) %s", rs.Primary.ID, err)
@@ -76,7 +79,7 @@ func CivoVolumeResourceExists(n string, volume *civogo.Volume) resource.TestChec
 }
 
 func CivoVolumeDestroy(s *terraform.State) error {
-	client := acceptance.TestAccProvider.Meta().(*civogo.Client)
+	client := acceptance.TestAccProvider.Meta().(*utils.CombinedConfig).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "civo_volume" {
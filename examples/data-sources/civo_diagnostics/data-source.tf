@@ -0,0 +1,5 @@
+data "civo_diagnostics" "current" {}
+
+output "civo_diagnostics" {
+  value = data.civo_diagnostics.current
+}
@@ -0,0 +1,13 @@
+data "civo_importable_resources" "web" {
+  type = "civo_instance"
+
+  filter {
+    key = "name"
+    values = ["web-"]
+    match_by = "prefix"
+  }
+}
+
+output "import_commands" {
+  value = data.civo_importable_resources.web.resources[*].import_command
+}
@@ -0,0 +1,8 @@
+data "civo_provider_info" "current" {}
+
+resource "civo_instance" "example" {
+    hostname = "example.com"
+    notes = "managed by terraform-provider-civo ${data.civo_provider_info.current.provider_version}"
+    firewall_id = civo_firewall.example.id
+    disk_image = element(data.civo_disk_image.debian.diskimages, 0).id
+}
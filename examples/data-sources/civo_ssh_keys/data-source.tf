@@ -0,0 +1,39 @@
+data "civo_ssh_keys" "team" {
+  filter {
+    key = "name"
+    values = ["team-"]
+    match_by = "prefix"
+  }
+
+  sort {
+    key = "name"
+    direction = "asc"
+  }
+}
+
+data "civo_size" "small" {
+  filter {
+    key = "name"
+    values = ["g3.small"]
+    match_by = "re"
+  }
+
+  filter {
+    key = "type"
+    values = ["instance"]
+  }
+}
+
+data "civo_disk_image" "debian" {
+  filter {
+    key = "name"
+    values = ["debian-10"]
+  }
+}
+
+resource "civo_instance" "my-test-instance" {
+    hostname = "foo.com"
+    size = element(data.civo_size.small.sizes, 0).name
+    disk_image = element(data.civo_disk_image.debian.diskimages, 0).id
+    sshkey_id = element(data.civo_ssh_keys.team.keys, 0).id
+}
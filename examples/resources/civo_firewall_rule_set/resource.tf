@@ -0,0 +1,19 @@
+# Create a firewall without the default rules
+resource "civo_firewall" "www" {
+    name = "www"
+    create_default_rules = false
+
+    egress_rule {
+        label = "all"
+        protocol = "tcp"
+        port_range = "1-65535"
+        cidr = ["0.0.0.0/0"]
+        action = "allow"
+    }
+}
+
+# Open HTTP and HTTPS on the firewall
+resource "civo_firewall_rule_set" "web" {
+    firewall_id = civo_firewall.www.id
+    preset = "web"
+}
@@ -1,2 +1,5 @@
 # using ID
 terraform import civo_object_store.custom_object b8ecd2ab-2267-4a5e-8692-cbf1d32583e3
+
+# using region and ID, for an Object Store outside the provider region
+terraform import civo_object_store.custom_object NYC1:b8ecd2ab-2267-4a5e-8692-cbf1d32583e3
@@ -0,0 +1,5 @@
+# using the resource type and the resource ID, all the current tags of the resource are managed after the import
+terraform import civo_resource_tags.web instance:18bd98ad-1b6e-4f87-b48f-e690b4fd7413
+
+# a resource outside the region of the provider is prefixed with its region
+terraform import civo_resource_tags.web LON1:instance:18bd98ad-1b6e-4f87-b48f-e690b4fd7413
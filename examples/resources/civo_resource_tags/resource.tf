@@ -0,0 +1,6 @@
+# Tag an instance which isn't managed by Terraform
+resource "civo_resource_tags" "web" {
+  resource_type = "instance"
+  resource_id   = "18bd98ad-1b6e-4f87-b48f-e690b4fd7413"
+  tags          = ["web", "production"]
+}
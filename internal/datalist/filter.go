@@ -50,8 +50,8 @@ func filterSchema(resultAttributeName string, allowedKeys []string) *schema.Sche
 					Type:         schema.TypeString,
 					Optional:     true,
 					Default:      "exact",
-					ValidateFunc: validation.StringInSlice([]string{"exact", "re", "substring"}, false),
-					Description:  "One of `exact` (default), `re`, or `substring`. For string-typed fields, specify `re` to match by using the `values` as regular expressions, or specify `substring` to match by treating the `values` as substrings to find within the string field.",
+					ValidateFunc: validation.StringInSlice([]string{"exact", "re", "substring", "prefix"}, false),
+					Description:  "One of `exact` (default), `re`, `substring` or `prefix`. For string-typed fields, specify `re` to match by using the `values` as regular expressions, specify `substring` to match by treating the `values` as substrings to find within the string field, or specify `prefix` to match the string fields starting with one of the `values`.",
 				},
 			},
 		},
@@ -125,7 +125,7 @@ func expandPrimitiveFilterValue(
 	switch fieldType {
 	case schema.TypeString:
 		switch matchBy {
-		case "exact", "substring":
+		case "exact", "substring", "prefix":
 			expandedValue = filterValue
 		case "re":
 			re, err := regexp.Compile(filterValue)
@@ -286,6 +286,16 @@ func TestApplyFilters(t *testing.T) {
 			},
 			[]string{"s-2vcpu-2gb", "m-1vcpu-8gb"},
 		},
+		{
+			"BySlugWithPrefix",
+			commonFilter{
+				"slug",
+				[]interface{}{"m-"},
+				false,
+				"prefix",
+			},
+			[]string{"m-1vcpu-8gb"},
+		},
 	}
 
 	for _, testCase := range testCases {
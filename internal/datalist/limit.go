@@ -0,0 +1,28 @@
+package datalist
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// LimitKey is the key in the extra query parameters holding the maximum number of records
+// GetRecords needs to return. It is only set when there is no `filter` or `sort`, as only then
+// the API can truncate the records without changing the results
+const LimitKey = "limit"
+
+func limitSchema(resultAttributeName string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The maximum number of `" + resultAttributeName + "` to return, applied after `filter` and `sort`",
+	}
+}
+
+// applyLimit returns at most limit records, a limit of 0 returns all of them
+func applyLimit(records []map[string]interface{}, limit int) []map[string]interface{} {
+	if limit <= 0 || len(records) <= limit {
+		return records
+	}
+	return records[:limit]
+}
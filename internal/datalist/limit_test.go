@@ -0,0 +1,22 @@
+package datalist
+
+import (
+	"testing"
+)
+
+func TestApplyLimit(t *testing.T) {
+	records := sizesTestDataForSorts()
+
+	if got := applyLimit(records, 0); len(got) != len(records) {
+		t.Errorf("expected all the records without a limit, got %d", len(got))
+	}
+
+	got := applyLimit(records, 2)
+	if len(got) != 2 || got[0]["slug"] != "s-1vcpu-1gb" || got[1]["slug"] != "s-2vcpu-2gb" {
+		t.Errorf("expected the first 2 records, got %v", got)
+	}
+
+	if got := applyLimit(records, 10); len(got) != len(records) {
+		t.Errorf("expected all the records when the limit is above the number of records, got %d", len(got))
+	}
+}
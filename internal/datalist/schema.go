@@ -31,7 +31,8 @@ type ResourceConfig struct {
 
 	// Return all of the records on which the data list resource should operate.
 	// The `meta` argument is the same meta argument passed into the resource's Read
-	// function.
+	// function. If `extra` has a LimitKey, only that many records are needed and
+	// the API can be asked for fewer records, otherwise the filtering is done here.
 	GetRecords func(meta interface{}, extra map[string]interface{}) ([]interface{}, error)
 
 	// Extra parameters to expose on the datasource alongside `filter` and `sort`.
@@ -68,6 +69,7 @@ func NewResource(config *ResourceConfig) *schema.Resource {
 	datasourceSchema := map[string]*schema.Schema{
 		"filter": filterSchema(config.ResultAttributeName, filterKeys),
 		"sort":   sortSchema(config.ResultAttributeName, sortKeys),
+		LimitKey: limitSchema(config.ResultAttributeName),
 		config.ResultAttributeName: {
 			Type:     schema.TypeList,
 			Computed: true,
@@ -95,6 +97,14 @@ func dataListResourceRead(config *ResourceConfig) schema.ReadContextFunc {
 			extra[key] = d.Get(key)
 		}
 
+		// the API can only truncate the records when there is nothing to filter or sort
+		limit := d.Get(LimitKey).(int)
+		_, hasFilter := d.GetOk("filter")
+		_, hasSort := d.GetOk("sort")
+		if limit > 0 && !hasFilter && !hasSort {
+			extra[LimitKey] = limit
+		}
+
 		records, err := config.GetRecords(meta, extra)
 		if err != nil {
 			return diag.Errorf("Unable to load records: %s", err)
@@ -122,6 +132,8 @@ func dataListResourceRead(config *ResourceConfig) schema.ReadContextFunc {
 			flattenedRecords = applySorts(config.RecordSchema, flattenedRecords, sorts)
 		}
 
+		flattenedRecords = applyLimit(flattenedRecords, limit)
+
 		d.SetId(resource.UniqueId())
 
 		if err := d.Set(config.ResultAttributeName, flattenedRecords); err != nil {
@@ -20,6 +20,8 @@ func valueMatches(s *schema.Schema, value interface{}, filterValue interface{},
 			return strings.EqualFold(filterValue.(string), value.(string))
 		case "substring":
 			return strings.Contains(value.(string), filterValue.(string))
+		case "prefix":
+			return strings.HasPrefix(value.(string), filterValue.(string))
 		case "re":
 			return filterValue.(*regexp.Regexp).MatchString(value.(string))
 		}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/civo/civogo"
+)
+
+// CheckRegionCapacity returns an error if the region is flagged as out of capacity, suggesting
+// the regions which still have capacity, a region missing from the list is not checked
+func CheckRegionCapacity(regions []civogo.Region, region string) error {
+	outOfCapacity := false
+	alternatives := []string{}
+	for _, r := range regions {
+		if strings.EqualFold(r.Code, region) {
+			outOfCapacity = r.OutOfCapacity
+			continue
+		}
+		if !r.OutOfCapacity {
+			alternatives = append(alternatives, r.Code)
+		}
+	}
+
+	if !outOfCapacity {
+		return nil
+	}
+
+	suggestion := "no other region has capacity either"
+	if len(alternatives) > 0 {
+		sort.Strings(alternatives)
+		suggestion = fmt.Sprintf("regions with capacity are %s", strings.Join(alternatives, ", "))
+	}
+
+	return fmt.Errorf("the region %s is out of capacity, %s. Set `skip_capacity_check` in the provider to skip this check", region, suggestion)
+}
+
+// ValidateRegionCapacity checks the region against the capacity flags returned by the regions endpoint
+func ValidateRegionCapacity(client *civogo.Client, region string) error {
+	regions, err := client.ListRegions()
+	if err != nil {
+		return fmt.Errorf("[ERR] failed to list the regions to check their capacity: %s", err)
+	}
+
+	return CheckRegionCapacity(regions, region)
+}
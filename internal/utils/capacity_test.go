@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/civo/civogo"
+)
+
+func TestCheckRegionCapacity(t *testing.T) {
+	regions := []civogo.Region{
+		{Code: "LON1"},
+		{Code: "NYC1", OutOfCapacity: true},
+		{Code: "FRA1"},
+		{Code: "PHX1", OutOfCapacity: true},
+	}
+
+	if err := CheckRegionCapacity(regions, "LON1"); err != nil {
+		t.Errorf("expected no error for a region with capacity, got %s", err)
+	}
+
+	if err := CheckRegionCapacity(regions, "AMS1"); err != nil {
+		t.Errorf("expected no error for an unknown region, got %s", err)
+	}
+
+	err := CheckRegionCapacity(regions, "nyc1")
+	if err == nil {
+		t.Fatalf("expected an error for a region out of capacity")
+	}
+	if !strings.Contains(err.Error(), "regions with capacity are FRA1, LON1") {
+		t.Errorf("expected the regions with capacity to be suggested, got %s", err)
+	}
+
+	err = CheckRegionCapacity([]civogo.Region{{Code: "NYC1", OutOfCapacity: true}}, "NYC1")
+	if err == nil || !strings.Contains(err.Error(), "no other region has capacity") {
+		t.Errorf("expected an error without alternatives, got %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/civo/civogo"
+)
+
+// CombinedConfig is the meta passed by the provider to every resource and data source,
+// it holds the Civo API client along with the provider level settings
+type CombinedConfig struct {
+	Client *civogo.Client
+
+	// Region and APIEndpoint are the ones the provider was configured with, the region of
+	// the client is overwritten by the resources declaring their own region
+	Region      string
+	APIEndpoint string
+
+	// DefaultAPIEndpoint is the api_endpoint of the provider and RegionAPIURLs maps region codes
+	// to the API URL serving them, the clients scoped to a region talk to its URL when it's listed
+	// and to DefaultAPIEndpoint otherwise
+	DefaultAPIEndpoint string
+	RegionAPIURLs      map[string]string
+
+	// SkipQuotaCheck disables the plan time quota check done for instances and clusters
+	SkipQuotaCheck bool
+
+	// SkipCapacityCheck disables the plan time check that the region of a new instance or
+	// cluster isn't out of capacity
+	SkipCapacityCheck bool
+
+	// DefaultFirewallID is the firewall given to the instances which don't declare their own
+	DefaultFirewallID string
+
+	// DefaultSSHKeyID is the SSH key given to the instances which don't declare their own
+	DefaultSSHKeyID string
+
+	// TagFirewalls maps instance tags to the firewall given to the instances with the tag which
+	// don't declare their own, it takes precedence over DefaultFirewallID
+	TagFirewalls map[string]string
+
+	// PollJitterPercent spreads the polling of the resources waiting on the API, so many resources
+	// created in the same apply don't all poll it at the same time
+	PollJitterPercent int
+
+	// MaxPollInterval caps the interval between two checks of a resource waiting on the API
+	MaxPollInterval time.Duration
+
+	// LogStateTransitions logs every change of state seen while waiting on the API
+	LogStateTransitions bool
+
+	// VerboseErrors adds the redacted response of the API to the errors
+	VerboseErrors bool
+
+	// InstanceCreates bounds how many instances are created at the same time
+	InstanceCreates Semaphore
+
+	// clients records the clients handed out by ClientForRegion when it's set, see RecordClients
+	clients *clientRecorder
+}
+
+// clientRecorder holds the clients handed out during a single call of a resource or data source
+type clientRecorder struct {
+	sync.Mutex
+	clients []*civogo.Client
+}
+
+// RecordClients returns a copy of the config with a client of its own, recording every client handed
+// out by ClientForRegion from it. LastAPIResponse then only sees the responses received through this
+// copy, not the ones of the resources handled at the same time
+func (c *CombinedConfig) RecordClients() *CombinedConfig {
+	client := *c.Client
+	client.LastJSONResponse = ""
+
+	config := *c
+	config.Client = &client
+	config.clients = &clientRecorder{clients: []*civogo.Client{&client}}
+	return &config
+}
+
+// LastAPIResponse returns the response received last by the most recently handed out client which
+// received one, or an empty string when the clients aren't recorded
+func (c *CombinedConfig) LastAPIResponse() string {
+	if c.clients == nil {
+		return ""
+	}
+
+	c.clients.Lock()
+	defer c.clients.Unlock()
+	for i := len(c.clients.clients) - 1; i >= 0; i-- {
+		if response := c.clients.clients[i].LastJSONResponse; response != "" {
+			return response
+		}
+	}
+	return ""
+}
+
+// sdkMaxPollInterval is the interval the backoff of the SDK stops growing at
+const sdkMaxPollInterval = 10 * time.Second
+
+// PollDelay returns the delay with the polling jitter of the provider applied, never above the
+// maximum polling interval
+func (c *CombinedConfig) PollDelay(base time.Duration) time.Duration {
+	return c.capPollInterval(Jitter(base, c.PollJitterPercent))
+}
+
+// PollInterval returns the fixed polling interval to use instead of the backoff of the SDK, which
+// grows up to 10 seconds, when the maximum polling interval is lower than that. Otherwise it's 0
+// and the backoff of the SDK is kept
+func (c *CombinedConfig) PollInterval() time.Duration {
+	if c.MaxPollInterval <= 0 || c.MaxPollInterval >= sdkMaxPollInterval {
+		return 0
+	}
+	return c.capPollInterval(Jitter(c.MaxPollInterval, c.PollJitterPercent))
+}
+
+// capPollInterval bounds the interval to the maximum polling interval, the jitter
+// can only make the interval shorter once it reaches it
+func (c *CombinedConfig) capPollInterval(interval time.Duration) time.Duration {
+	if c.MaxPollInterval > 0 && interval > c.MaxPollInterval {
+		return c.MaxPollInterval
+	}
+	return interval
+}
+
+// ClientForRegion returns a copy of the client scoped to the region, or to the region of the provider
+// when it's empty, talking to the API URL of that region. The shared client is never modified, so
+// resources in different regions can be handled at the same time. Resources pass the region in their
+// state since the shared client may point to another region, data sources the one of ResolveRegion
+func ClientForRegion(config *CombinedConfig, region string) *civogo.Client {
+	client := *config.Client
+	client.LastJSONResponse = ""
+	if region != "" {
+		client.Region = region
+	} else if config.Region != "" {
+		client.Region = config.Region
+	}
+
+	if len(config.RegionAPIURLs) > 0 {
+		if apiURL := APIURLForRegion(client.Region, config.DefaultAPIEndpoint, config.RegionAPIURLs); apiURL != "" {
+			if baseURL, err := url.Parse(apiURL); err == nil {
+				client.BaseURL = baseURL
+			}
+		}
+	}
+
+	if config.clients != nil {
+		config.clients.Lock()
+		config.clients.clients = append(config.clients.clients, &client)
+		config.clients.Unlock()
+	}
+
+	return &client
+}
+
+// APIURLForRegion returns the API URL configured for the region, or the default URL if the region isn't listed
+func APIURLForRegion(region, defaultURL string, regionURLs map[string]string) string {
+	for code, u := range regionURLs {
+		if strings.EqualFold(code, region) {
+			return u
+		}
+	}
+
+	return defaultURL
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Jitter returns the duration randomly spread by up to percent of it in either direction,
+// the percent is clamped between 0 and 100 so the duration never goes negative
+func Jitter(base time.Duration, percent int) time.Duration {
+	if percent <= 0 || base <= 0 {
+		return base
+	}
+	if percent > 100 {
+		percent = 100
+	}
+
+	spread := int64(base) * int64(percent) / 100
+	if spread == 0 {
+		return base
+	}
+
+	return base - time.Duration(spread) + time.Duration(rand.Int63n(2*spread+1))
+}
+
+// JitterPolls wraps the refresh function of a wait so every poll after the first one is delayed by a
+// random part of the polling jitter of the provider. The backoff of the SDK settles on a fixed interval,
+// so without it the resources waiting together would keep polling the API at the same time
+func (c *CombinedConfig) JitterPolls(ctx context.Context, refresh retry.StateRefreshFunc) retry.StateRefreshFunc {
+	spread := c.pollJitterSpread()
+	if spread <= 0 {
+		return refresh
+	}
+
+	first := true
+	return func() (interface{}, string, error) {
+		if !first {
+			select {
+			case <-time.After(time.Duration(rand.Int63n(int64(spread) + 1))):
+			case <-ctx.Done():
+				return nil, "", ctx.Err()
+			}
+		}
+		first = false
+
+		return refresh()
+	}
+}
+
+// pollJitterSpread returns the longest delay JitterPolls adds to a poll, the polling jitter of the
+// provider applied to the interval the polling settles on
+func (c *CombinedConfig) pollJitterSpread() time.Duration {
+	if c.PollJitterPercent <= 0 {
+		return 0
+	}
+
+	percent := c.PollJitterPercent
+	if percent > 100 {
+		percent = 100
+	}
+
+	interval := sdkMaxPollInterval
+	if c.MaxPollInterval > 0 && c.MaxPollInterval < interval {
+		interval = c.MaxPollInterval
+	}
+
+	return interval * time.Duration(percent) / 100
+}
@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func TestJitter(t *testing.T) {
+	base := 3 * time.Second
+	min, max := 2700*time.Millisecond, 3300*time.Millisecond
+
+	seen := map[time.Duration]bool{}
+	for i := 0; i < 100; i++ {
+		got := Jitter(base, 10)
+		if got < min || got > max {
+			t.Fatalf("expected the delay between %s and %s, got %s", min, max, got)
+		}
+		seen[got] = true
+	}
+
+	if len(seen) < 2 {
+		t.Errorf("expected the delays to vary, got %v", seen)
+	}
+}
+
+func TestJitterBounds(t *testing.T) {
+	base := 3 * time.Second
+
+	if got := Jitter(base, 0); got != base {
+		t.Errorf("expected no jitter, got %s", got)
+	}
+
+	for i := 0; i < 100; i++ {
+		if got := Jitter(base, 500); got < 0 || got > 2*base {
+			t.Fatalf("expected the jitter to be clamped, got %s", got)
+		}
+	}
+}
+
+func TestPollIntervalCap(t *testing.T) {
+	config := &CombinedConfig{MaxPollInterval: 5 * time.Second, PollJitterPercent: 50}
+
+	for i := 0; i < 100; i++ {
+		if got := config.PollInterval(); got <= 0 || got > config.MaxPollInterval {
+			t.Fatalf("expected the interval between 0 and %s, got %s", config.MaxPollInterval, got)
+		}
+		if got := config.PollDelay(8 * time.Second); got > config.MaxPollInterval {
+			t.Fatalf("expected the delay to be capped to %s, got %s", config.MaxPollInterval, got)
+		}
+	}
+
+	// the backoff of the SDK already stops at 10 seconds
+	config.MaxPollInterval = 10 * time.Second
+	if got := config.PollInterval(); got != 0 {
+		t.Errorf("expected the backoff of the SDK to be kept, got %s", got)
+	}
+}
+
+func TestJitterPolls(t *testing.T) {
+	config := &CombinedConfig{MaxPollInterval: 100 * time.Millisecond, PollJitterPercent: 50}
+	spread := 50 * time.Millisecond
+
+	polls := []time.Time{}
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: config.JitterPolls(context.Background(), func() (interface{}, string, error) {
+			polls = append(polls, time.Now())
+			if len(polls) < 8 {
+				return "", "pending", nil
+			}
+			return "", "done", nil
+		}),
+		Timeout:      time.Minute,
+		PollInterval: config.MaxPollInterval,
+	}
+	if _, err := stateConf.WaitForStateContext(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// every interval between two polls gets its own jitter, even at the fixed interval
+	shortest, longest := time.Hour, time.Duration(0)
+	for i := 1; i < len(polls); i++ {
+		interval := polls[i].Sub(polls[i-1])
+		if interval < config.MaxPollInterval {
+			t.Errorf("expected the interval %d to be at least %s, got %s", i, config.MaxPollInterval, interval)
+		}
+		if interval > config.MaxPollInterval+spread+50*time.Millisecond {
+			t.Errorf("expected the interval %d to be at most %s, got %s", i, config.MaxPollInterval+spread, interval)
+		}
+		if interval < shortest {
+			shortest = interval
+		}
+		if interval > longest {
+			longest = interval
+		}
+	}
+	if longest-shortest < 5*time.Millisecond {
+		t.Errorf("expected the intervals between the polls to vary, got between %s and %s", shortest, longest)
+	}
+}
+
+func TestJitterPollsDisabled(t *testing.T) {
+	config := &CombinedConfig{PollJitterPercent: 0}
+
+	calls := 0
+	refresh := config.JitterPolls(context.Background(), func() (interface{}, string, error) {
+		calls++
+		return "", "done", nil
+	})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		refresh()
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Millisecond || calls != 3 {
+		t.Errorf("expected no delay without jitter, got %d calls in %s", calls, elapsed)
+	}
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/civo/civogo"
+)
+
+// QuotaRequest holds the resources a new instance or cluster is going to consume
+type QuotaRequest struct {
+	Instances     int
+	CPUCores      int
+	RAMMegabytes  int
+	DiskGigabytes int
+}
+
+// AddSize adds the resources used by count instances of the given size to the request
+func (r *QuotaRequest) AddSize(size civogo.InstanceSize, count int) {
+	r.Instances += count
+	r.CPUCores += size.CPUCores * count
+	r.RAMMegabytes += size.RAMMegabytes * count
+	r.DiskGigabytes += size.DiskGigabytes * count
+}
+
+// CheckQuota returns an error if the request doesn't fit in the remaining quota of the account,
+// a limit of zero or less is considered as unlimited
+func CheckQuota(quota *civogo.Quota, request QuotaRequest) error {
+	checks := []struct {
+		name      string
+		usage     int
+		limit     int
+		requested int
+	}{
+		{"instances", quota.InstanceCountUsage, quota.InstanceCountLimit, request.Instances},
+		{"CPU cores", quota.CPUCoreUsage, quota.CPUCoreLimit, request.CPUCores},
+		{"RAM (MB)", quota.RAMMegabytesUsage, quota.RAMMegabytesLimit, request.RAMMegabytes},
+		{"disk (GB)", quota.DiskGigabytesUsage, quota.DiskGigabytesLimit, request.DiskGigabytes},
+	}
+
+	for _, check := range checks {
+		if check.limit <= 0 {
+			continue
+		}
+
+		if check.usage+check.requested > check.limit {
+			return fmt.Errorf("this would exceed the account quota for %s: %d in use, %d requested, the limit is %d. You can request a quota increase at https://dashboard.civo.com/quota or set `skip_quota_check` in the provider to skip this check", check.name, check.usage, check.requested, check.limit)
+		}
+	}
+
+	return nil
+}
+
+// ValidateQuota looks up the sizes requested, the key is the size name and the value the number
+// of instances of that size, and checks them against the current quota of the account
+func ValidateQuota(client *civogo.Client, sizes map[string]int) error {
+	allSizes, err := client.ListInstanceSizes()
+	if err != nil {
+		return fmt.Errorf("[ERR] failed to list the sizes to check the quota: %s", err)
+	}
+
+	request := QuotaRequest{}
+	for _, size := range allSizes {
+		if count, ok := sizes[size.Name]; ok {
+			request.AddSize(size, count)
+		}
+	}
+
+	quota, err := client.GetQuota()
+	if err != nil {
+		return fmt.Errorf("[ERR] failed to retrieve the account quota: %s", err)
+	}
+
+	return CheckQuota(quota, request)
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/civo/civogo"
+)
+
+func TestCheckQuota(t *testing.T) {
+	quota := &civogo.Quota{
+		InstanceCountLimit: 10,
+		InstanceCountUsage: 8,
+		CPUCoreLimit:       20,
+		CPUCoreUsage:       16,
+		RAMMegabytesLimit:  40960,
+		RAMMegabytesUsage:  32768,
+		DiskGigabytesLimit: 0,
+		DiskGigabytesUsage: 500,
+	}
+
+	size := civogo.InstanceSize{Name: "g3.medium", CPUCores: 2, RAMMegabytes: 4096, DiskGigabytes: 50}
+
+	cases := []struct {
+		name    string
+		count   int
+		wantErr bool
+	}{
+		{"within quota", 1, false},
+		{"exactly at the limit", 2, false},
+		{"over quota", 3, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			request := QuotaRequest{}
+			request.AddSize(size, c.count)
+
+			err := CheckQuota(quota, request)
+			if (err != nil) != c.wantErr {
+				t.Errorf("CheckQuota() error = %v, wantErr %v", err, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateQuota(t *testing.T) {
+	client, server, _ := civogo.NewClientForTesting(map[string]string{
+		"/v2/sizes": `[{"name": "g3.large", "cpu_cores": 4, "ram_mb": 8192, "disk_gb": 100}]`,
+		"/v2/quota": `{"instance_count_limit": 16, "instance_count_usage": 2, "cpu_core_limit": 16, "cpu_core_usage": 8, "ram_mb_limit": 65536, "ram_mb_usage": 16384, "disk_gb_limit": 1600, "disk_gb_usage": 200}`,
+	})
+	defer server.Close()
+
+	if err := ValidateQuota(client, map[string]int{"g3.large": 2}); err != nil {
+		t.Errorf("expected two g3.large instances to fit in the quota, got %s", err)
+	}
+
+	if err := ValidateQuota(client, map[string]int{"g3.large": 3}); err == nil {
+		t.Errorf("expected three g3.large instances to exceed the CPU quota")
+	}
+}
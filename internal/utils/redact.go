@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// sensitiveKeyParts are the parts of a JSON key which mark its value as a secret
+var sensitiveKeyParts = []string{"password", "secret", "token", "kubeconfig", "api_key", "private_key"}
+
+// RedactedAPIResponse returns the body of an API response with the values of its secret looking
+// keys replaced, cut to maxLength characters, so it can be shown in an error. A body which isn't
+// JSON can't be redacted, so only its length is returned
+func RedactedAPIResponse(body string, maxLength int) string {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(body), &decoded); err != nil {
+		return fmt.Sprintf("[non-JSON response of %d bytes omitted]", len(body))
+	}
+
+	redacted, err := json.Marshal(redactSecrets(decoded))
+	if err != nil {
+		return fmt.Sprintf("[response of %d bytes omitted]", len(body))
+	}
+	body = string(redacted)
+
+	if len(body) > maxLength {
+		body = body[:maxLength] + "..."
+	}
+	return body
+}
+
+func redactSecrets(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isSensitiveKey(key) {
+				v[key] = "[REDACTED]"
+			} else {
+				v[key] = redactSecrets(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactSecrets(item)
+		}
+	}
+	return value
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
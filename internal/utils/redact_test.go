@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactedAPIResponse(t *testing.T) {
+	body := `{"id": "12345", "kubeconfig": "apiVersion: v1", "credentials": [{"access_key_id": "AKIA", "secret_access_key": "s3cr3t"}]}`
+
+	got := RedactedAPIResponse(body, 500)
+	for _, secret := range []string{"apiVersion: v1", "s3cr3t"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("expected %q to be redacted, got %s", secret, got)
+		}
+	}
+	if !strings.Contains(got, `"access_key_id":"AKIA"`) {
+		t.Errorf("expected the non secret values to be kept, got %s", got)
+	}
+
+	if got := RedactedAPIResponse(`"`+strings.Repeat("a", 20)+`"`, 10); got != `"aaaaaaaaa...` {
+		t.Errorf("expected the response to be cut, got %s", got)
+	}
+
+	// a body which isn't JSON can't be redacted, so it's left out
+	if got := RedactedAPIResponse("password=hunter2", 500); strings.Contains(got, "hunter2") {
+		t.Errorf("expected the non-JSON response to be left out, got %s", got)
+	}
+}
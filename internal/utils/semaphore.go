@@ -0,0 +1,37 @@
+package utils
+
+import "context"
+
+// Semaphore bounds how many operations run at the same time, a nil Semaphore doesn't bound them
+type Semaphore chan struct{}
+
+// NewSemaphore returns a Semaphore letting size operations run at the same time, or nil when
+// size isn't positive
+func NewSemaphore(size int) Semaphore {
+	if size <= 0 {
+		return nil
+	}
+	return make(Semaphore, size)
+}
+
+// Acquire waits for a slot, or returns the error of the context if it's done first
+func (s Semaphore) Acquire(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees the slot taken by Acquire
+func (s Semaphore) Release() {
+	if s == nil {
+		return
+	}
+	<-s
+}
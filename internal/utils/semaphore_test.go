@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSemaphoreBoundsConcurrency(t *testing.T) {
+	semaphore := NewSemaphore(2)
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := semaphore.Acquire(context.Background()); err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			defer semaphore.Release()
+
+			mu.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if maxRunning != 2 {
+		t.Errorf("expected at most 2 operations at the same time, got %d", maxRunning)
+	}
+}
+
+func TestSemaphoreRespectsCancellation(t *testing.T) {
+	semaphore := NewSemaphore(1)
+	if err := semaphore.Acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := semaphore.Acquire(ctx); err == nil {
+		t.Errorf("expected the wait for a slot to be cancelled")
+	}
+}
+
+func TestSemaphoreUnbounded(t *testing.T) {
+	semaphore := NewSemaphore(0)
+	for i := 0; i < 100; i++ {
+		if err := semaphore.Acquire(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	semaphore.Release()
+}
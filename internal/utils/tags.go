@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// The limits Civo applies to the tags of a resource
+const (
+	// MaxTags is the maximum number of tags on a single resource
+	MaxTags = 50
+
+	// MaxTagLength is the maximum number of characters of a single tag
+	MaxTagLength = 255
+)
+
+// CheckTags returns an error if there are too many tags or one of them is too long
+func CheckTags(tags []string) error {
+	if len(tags) > MaxTags {
+		return fmt.Errorf("a resource can have at most %d tags, got %d", MaxTags, len(tags))
+	}
+
+	for _, tag := range tags {
+		if length := utf8.RuneCountInString(tag); length > MaxTagLength {
+			return fmt.Errorf("the tag %q is %d characters long, the maximum is %d", tag, length, MaxTagLength)
+		}
+	}
+
+	return nil
+}
+
+// ValidateTag is a function to check the length of a single tag
+func ValidateTag(v interface{}, _ string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected tag to be string")}
+	}
+
+	if err := CheckTags([]string{value}); err != nil {
+		return nil, []error{err}
+	}
+
+	return nil, nil
+}
+
+// ValidateSpaceSeparatedTags is a function to check the tags given as a space separated list
+func ValidateSpaceSeparatedTags(v interface{}, _ string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected tags to be string")}
+	}
+
+	if err := CheckTags(strings.Fields(value)); err != nil {
+		return nil, []error{err}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCheckTags(t *testing.T) {
+	tagList := func(count int) []string {
+		tags := make([]string, count)
+		for i := range tags {
+			tags[i] = "tag"
+		}
+		return tags
+	}
+
+	cases := []struct {
+		name    string
+		tags    []string
+		wantErr bool
+	}{
+		{"below the count limit", tagList(MaxTags - 1), false},
+		{"at the count limit", tagList(MaxTags), false},
+		{"above the count limit", tagList(MaxTags + 1), true},
+		{"below the length limit", []string{strings.Repeat("a", MaxTagLength-1)}, false},
+		{"at the length limit", []string{strings.Repeat("a", MaxTagLength)}, false},
+		{"above the length limit", []string{"web", strings.Repeat("a", MaxTagLength+1)}, true},
+		// each é is 2 bytes but a single character
+		{"multibyte at the length limit", []string{strings.Repeat("é", MaxTagLength)}, false},
+		{"multibyte above the length limit", []string{strings.Repeat("é", MaxTagLength+1)}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := CheckTags(c.tags)
+			if (err != nil) != c.wantErr {
+				t.Errorf("CheckTags() error = %v, wantErr %v", err, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckTagsCountsCharacters(t *testing.T) {
+	err := CheckTags([]string{strings.Repeat("é", MaxTagLength+1)})
+	if err == nil || !strings.Contains(err.Error(), fmt.Sprintf("is %d characters long", MaxTagLength+1)) {
+		t.Errorf("expected the length in characters in the error, got %v", err)
+	}
+}
+
+func TestValidateSpaceSeparatedTags(t *testing.T) {
+	tooLong := strings.Repeat("a", MaxTagLength+1)
+
+	if _, errs := ValidateSpaceSeparatedTags("web production", "tags"); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	_, errs := ValidateSpaceSeparatedTags("web "+tooLong, "tags")
+	if len(errs) != 1 {
+		t.Fatalf("expected one error, got %v", errs)
+	}
+	if !strings.Contains(errs[0].Error(), tooLong) {
+		t.Errorf("expected the error to name the offending tag, got %s", errs[0])
+	}
+}
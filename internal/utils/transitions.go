@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// LogTransitions wraps the refresh function of a wait so every change of the state it returns is
+// logged at info level along with the time elapsed since the wait started, when the provider has
+// log_state_transitions set. what names the resource being waited on in the log lines
+func (c *CombinedConfig) LogTransitions(ctx context.Context, what string, refresh retry.StateRefreshFunc) retry.StateRefreshFunc {
+	if !c.LogStateTransitions {
+		return refresh
+	}
+
+	start := time.Now()
+	observed := false
+	last := ""
+
+	return func() (interface{}, string, error) {
+		result, state, err := refresh()
+		if err != nil || (observed && state == last) {
+			return result, state, err
+		}
+
+		elapsed := time.Since(start).Round(time.Second)
+		fields := map[string]interface{}{
+			"resource": what,
+			"state":    state,
+			"elapsed":  elapsed.String(),
+		}
+
+		if observed {
+			fields["previous_state"] = last
+			tflog.Info(ctx, fmt.Sprintf("%s went from %q to %q after %s", what, last, state, elapsed), fields)
+		} else {
+			tflog.Info(ctx, fmt.Sprintf("%s is %q after %s", what, state, elapsed), fields)
+		}
+
+		observed = true
+		last = state
+
+		return result, state, err
+	}
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogTransitions(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	// the fake client reports the same state twice before moving on
+	states := []string{"BUILDING", "BUILDING", "STARTING", "ACTIVE"}
+	step := 0
+	refresh := func() (interface{}, string, error) {
+		state := states[step]
+		step++
+		return state, state, nil
+	}
+
+	config := &CombinedConfig{LogStateTransitions: true}
+	logged := config.LogTransitions(ctx, "instance 12345", refresh)
+	for range states {
+		if _, _, err := logged(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("failed to decode the logs: %s", err)
+	}
+
+	expected := []string{
+		`instance 12345 is "BUILDING" after 0s`,
+		`instance 12345 went from "BUILDING" to "STARTING" after 0s`,
+		`instance 12345 went from "STARTING" to "ACTIVE" after 0s`,
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %d log lines, got %d: %v", len(expected), len(entries), entries)
+	}
+
+	for i, entry := range entries {
+		if entry["@message"] != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], entry["@message"])
+		}
+		if entry["@level"] != "info" {
+			t.Errorf("expected the info level, got %v", entry["@level"])
+		}
+		if _, ok := entry["elapsed"]; !ok {
+			t.Errorf("expected the elapsed time in the fields, got %v", entry)
+		}
+	}
+}
+
+func TestLogTransitionsDisabled(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	refresh := func() (interface{}, string, error) { return nil, "ACTIVE", nil }
+
+	config := &CombinedConfig{}
+	if _, _, err := config.LogTransitions(ctx, "instance 12345", refresh)(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if output.Len() != 0 {
+		t.Errorf("expected nothing to be logged, got %s", output.String())
+	}
+}
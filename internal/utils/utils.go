@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -317,3 +318,51 @@ func ValidateUUID(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+// ResolveRegion returns the region a data source or resource should use. The region set
+// on the data source or resource takes precedence over the provider region, which itself
+// falls back to the CIVO_REGION environment variable. The data sources then get a client
+// for it from ClientForRegion, leaving the region of the shared client alone.
+func ResolveRegion(region string, config *CombinedConfig) (string, error) {
+	if region != "" {
+		return region, nil
+	}
+
+	if config.Region != "" {
+		return config.Region, nil
+	}
+
+	if config.Client.Region != "" {
+		return config.Client.Region, nil
+	}
+
+	return "", fmt.Errorf("no region could be resolved, please set `region` in the data source, in the provider or with the CIVO_REGION environment variable")
+}
+
+// RegionFromResponse returns the region returned by the API for a resource, falling back to the
+// region the client is configured with when the API omits it, so the region is never blank in the state
+// Of the structs the resources read only civogo.Instance carries a region, the others (databases,
+// networks, firewalls, reserved IPs, volumes, Kubernetes clusters) take the region of their
+// region-scoped client instead
+func RegionFromResponse(region string, client *civogo.Client) string {
+	if region != "" {
+		return region
+	}
+	return client.Region
+}
+
+// FormatTime returns the time in UTC formatted as RFC3339, so all the timestamps across the
+// provider look the same, or an empty string if the time is not set
+func FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// URN returns the uniform resource name of a resource, in the form civo:<type>:<region>:<id>,
+// which stays the same for the life of the resource. The region is lowercased, as the API
+// is not consistent about the case of region codes
+func URN(resourceType, region, id string) string {
+	return fmt.Sprintf("civo:%s:%s:%s", resourceType, strings.ToLower(region), id)
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/civo/civogo"
+)
+
+func TestResolveRegion(t *testing.T) {
+	cases := []struct {
+		name           string
+		region         string
+		providerRegion string
+		expected       string
+		expectErr      bool
+	}{
+		{
+			name:           "explicit region takes precedence",
+			region:         "NYC1",
+			providerRegion: "LON1",
+			expected:       "NYC1",
+		},
+		{
+			name:           "inherits the provider region",
+			region:         "",
+			providerRegion: "LON1",
+			expected:       "LON1",
+		},
+		{
+			name:      "no region resolved",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// another resource left the shared client pointing to FRA1, it must not be used
+			config := &CombinedConfig{Client: &civogo.Client{Region: "FRA1"}, Region: tc.providerRegion}
+			if tc.expectErr {
+				config.Client.Region = ""
+			}
+
+			region, err := ResolveRegion(tc.region, config)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error, got region %q", region)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if region != tc.expected {
+				t.Fatalf("expected region %q, got %q", tc.expected, region)
+			}
+		})
+	}
+}
+
+// TestRegionFromResponse tests that the client region is used when the API response has no region
+func TestRegionFromResponse(t *testing.T) {
+	client := &civogo.Client{Region: "LON1"}
+
+	if got := RegionFromResponse("NYC1", client); got != "NYC1" {
+		t.Errorf("expected the region from the response, got %q", got)
+	}
+
+	if got := RegionFromResponse("", client); got != "LON1" {
+		t.Errorf("expected the configured region when the response lacks one, got %q", got)
+	}
+}
+
+// TestClientForRegion tests that the region of the resource wins over the provider region without touching the shared client
+func TestClientForRegion(t *testing.T) {
+	// another resource left the shared client pointing to FRA1
+	config := &CombinedConfig{Client: &civogo.Client{Region: "FRA1"}, Region: "LON1"}
+
+	if got := ClientForRegion(config, "NYC1").Region; got != "NYC1" {
+		t.Errorf("expected the region of the resource, got %q", got)
+	}
+
+	if got := ClientForRegion(config, "").Region; got != "LON1" {
+		t.Errorf("expected the region of the provider, got %q", got)
+	}
+
+	if config.Client.Region != "FRA1" {
+		t.Errorf("expected the shared client to be left alone, got %q", config.Client.Region)
+	}
+}
+
+// TestAPIURLForRegion tests the per region API URL overrides
+func TestAPIURLForRegion(t *testing.T) {
+	regionURLs := map[string]string{
+		"SOV1": "https://api.sov1.example.com",
+	}
+
+	if got := APIURLForRegion("SOV1", "https://api.civo.com", regionURLs); got != "https://api.sov1.example.com" {
+		t.Errorf("expected the override URL for a mapped region, got %s", got)
+	}
+
+	if got := APIURLForRegion("sov1", "https://api.civo.com", regionURLs); got != "https://api.sov1.example.com" {
+		t.Errorf("expected the region code to be matched case insensitively, got %s", got)
+	}
+
+	if got := APIURLForRegion("LON1", "https://api.civo.com", regionURLs); got != "https://api.civo.com" {
+		t.Errorf("expected the default URL for a region not listed, got %s", got)
+	}
+}
+
+// TestClientForRegionAPIURL tests that a client scoped to a region talks to the API URL of the region
+func TestClientForRegionAPIURL(t *testing.T) {
+	providerURL, _ := url.Parse("https://api.sov1.example.com")
+	config := &CombinedConfig{
+		Client:             &civogo.Client{Region: "SOV1", BaseURL: providerURL},
+		Region:             "SOV1",
+		DefaultAPIEndpoint: "https://api.civo.com",
+		RegionAPIURLs: map[string]string{
+			"SOV1": "https://api.sov1.example.com",
+			"SOV2": "https://api.sov2.example.com",
+		},
+	}
+
+	cases := map[string]string{
+		"":     "https://api.sov1.example.com",
+		"sov2": "https://api.sov2.example.com",
+		"LON1": "https://api.civo.com",
+	}
+	for region, expected := range cases {
+		if got := ClientForRegion(config, region).BaseURL.String(); got != expected {
+			t.Errorf("expected %s for the region %q, got %s", expected, region, got)
+		}
+	}
+
+	if got := config.Client.BaseURL.String(); got != "https://api.sov1.example.com" {
+		t.Errorf("expected the shared client to be left alone, got %s", got)
+	}
+}
+
+// TestFormatTime tests the formatting of populated and zero-value timestamps
+func TestFormatTime(t *testing.T) {
+	created := time.Date(2024, time.March, 5, 14, 30, 0, 0, time.FixedZone("CET", 3600))
+	if got := FormatTime(created); got != "2024-03-05T13:30:00Z" {
+		t.Errorf("expected the time in UTC as RFC3339, got %q", got)
+	}
+
+	if got := FormatTime(time.Time{}); got != "" {
+		t.Errorf("expected an empty string for a zero time, got %q", got)
+	}
+}
+
+// TestURN tests the format of the uniform resource name
+func TestURN(t *testing.T) {
+	if got := URN("instance", "LON1", "b8a9ff5b"); got != "civo:instance:lon1:b8a9ff5b" {
+		t.Errorf("expected civo:instance:lon1:b8a9ff5b, got %q", got)
+	}
+}